@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -27,6 +28,12 @@ func main() {
 				fiveCardRunIteration()
 			}()
 
+		case "2":
+
+			go func() {
+				sevenCardParallelRunIteration()
+			}()
+
 		case "Q":
 			fmt.Println("bye")
 			return
@@ -42,6 +49,7 @@ func printMenu() {
 	fmt.Print("+++ Keith Rule Hand Evaluator in Go +++\n\n")
 	fmt.Println("What do you want to do?")
 	fmt.Println("1 - Run Benchmarks")
+	fmt.Println("2 - Run Parallel Benchmarks")
 	fmt.Println("Q - Quit")
 
 }
@@ -75,6 +83,45 @@ func fiveCardRunIteration() {
 	fmt.Printf("Hands/s: %f\n", handsPerSecond)
 }
 
+func sevenCardParallelRunIteration() {
+	fmt.Println("Seven card parallel run iteration benchmark...")
+	start := time.Now()
+
+	handTypes, err := holdemHand.HandsRangeParallel(context.Background(), 7, holdemHand.ParallelOptions{},
+		func() *[9]int { return &[9]int{} },
+		func(handTypes *[9]int, mask uint64) { handTypes[holdemHand.EvaluateType(mask)]++ },
+		func(dst *[9]int, src *[9]int) {
+			for i := range dst {
+				dst[i] += src[i]
+			}
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	Assert(func() bool { return handTypes[holdemHand.HighCard] != 23294460 }, "Unexpected HighCard count")
+	Assert(func() bool { return handTypes[holdemHand.Pair] != 58627800 }, "Unexpected Pair count")
+	Assert(func() bool { return handTypes[holdemHand.TwoPair] != 31433400 }, "Unexpected TwoPair count")
+	Assert(func() bool { return handTypes[holdemHand.Trips] != 6461620 }, "Unexpected Trips count")
+	Assert(func() bool { return handTypes[holdemHand.Straight] != 6180020 }, "Unexpected Straight count")
+	Assert(func() bool { return handTypes[holdemHand.Flush] != 4047644 }, "Unexpected Flush count")
+	Assert(func() bool { return handTypes[holdemHand.FullHouse] != 3473184 }, "Unexpected Fullhouse count")
+	Assert(func() bool { return handTypes[holdemHand.FourOfAKind] != 224848 }, "Unexpected FourOfAKind count")
+	Assert(func() bool { return handTypes[holdemHand.StraightFlush] != 41584 }, "Unexpected StraightFlush count")
+
+	count := 0
+	for _, n := range handTypes {
+		count += n
+	}
+
+	endTime := time.Since(start)
+
+	fmt.Printf("Elapsed: %v\n", endTime.Seconds())
+	fmt.Printf("Total hands: %d\n", count)
+
+	handsPerSecond := float64(count) / endTime.Seconds()
+	fmt.Printf("Hands/s: %f\n", handsPerSecond)
+}
+
 type AssertFunc func() bool
 
 func Assert(assertFunc AssertFunc, msg string) {
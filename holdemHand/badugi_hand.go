@@ -0,0 +1,110 @@
+package holdemHand
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	BADUGI_SIZE_SHIFT uint = 16
+	BADUGI_CARD_WIDTH uint = 4
+)
+
+var badugiRankTable = [13]string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "T", "J", "Q", "K"}
+
+// Evaluates a four card badugi hand and returns a hand value. The hand plays the
+// largest subset of cards with distinct suits and distinct ranks, aces are low.
+// A hand value can be compared against another badugi hand value, the higher
+// value is the better hand: more cards first, then the lowest highest card.
+func EvaluateBadugi(mask uint64) (uint, error) {
+	if bitCount(mask) != 4 || mask>>NumberOfCards != 0 {
+		return 0, errors.New("Badugi hands have four cards")
+	}
+
+	var cards [4]int
+	count := 0
+	for card := NumberOfCards - 1; card >= 0; card-- {
+		if mask&CardMasksTable[card] != 0 {
+			cards[count] = card
+			count++
+		}
+	}
+
+	best := uint(0)
+	for subset := 1; subset < 16; subset++ {
+		suits := 0
+		lowRanks := 0
+		valid := true
+		for i := 0; i < 4 && valid; i++ {
+			if subset&(1<<i) == 0 {
+				continue
+			}
+			suit := 1 << (cards[i] / 13)
+			lowRank := 1 << badugiLowRank(cards[i])
+			valid = suits&suit == 0 && lowRanks&lowRank == 0
+			suits |= suit
+			lowRanks |= lowRank
+		}
+
+		if !valid {
+			continue
+		}
+
+		// highest card first, each card is stored so that lower ranks are worth more
+		value := uint(BitsTable[lowRanks]) << BADUGI_SIZE_SHIFT
+		shift := 3 * BADUGI_CARD_WIDTH
+		for rank := 12; rank >= 0; rank-- {
+			if lowRanks&(1<<rank) != 0 {
+				value |= uint(12-rank) << shift
+				shift -= BADUGI_CARD_WIDTH
+			}
+		}
+
+		if value > best {
+			best = value
+		}
+	}
+
+	return best, nil
+}
+
+// Evaluates a badugi hand passed as a string and returns a hand value.
+func EvaluateBadugiText(hand string) (uint, error) {
+	mask, e := ParseHand(hand)
+	if e != nil {
+		return 0, e
+	}
+	return EvaluateBadugi(mask)
+}
+
+// Converts a badugi hand value into descriptive text, such as 4-card 8-6-3-A badugi
+func BadugiDescription(handValue uint) string {
+	sb := strings.Builder{}
+	size := handValue >> BADUGI_SIZE_SHIFT
+	if size < 1 || size > 4 {
+		return ""
+	}
+
+	sb.WriteByte(byte('0' + size))
+	sb.WriteString("-card ")
+
+	shift := 3 * BADUGI_CARD_WIDTH
+	for i := uint(0); i < size; i++ {
+		if i > 0 {
+			sb.WriteString("-")
+		}
+		sb.WriteString(badugiRankTable[12-((handValue>>shift)&CARD_MASK)])
+		shift -= BADUGI_CARD_WIDTH
+	}
+
+	if size == 4 {
+		sb.WriteString(" badugi")
+	}
+
+	return sb.String()
+}
+
+// ace is the lowest card in badugi
+func badugiLowRank(card int) int {
+	return (card%13 + 1) % 13
+}
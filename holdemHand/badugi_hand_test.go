@@ -0,0 +1,88 @@
+package holdemHand
+
+import (
+	"testing"
+)
+
+func TestEvaluateBadugi(t *testing.T) {
+	tests := []struct {
+		hand string
+		want string
+	}{
+		{"8s 6h 3d Ac", "4-card 8-6-3-A badugi"},
+		{"4s 3h 2d Ac", "4-card 4-3-2-A badugi"},
+		{"Ks Qh Jd Tc", "4-card K-Q-J-T badugi"},
+		{"8s 6s 3d Ac", "3-card 6-3-A"},
+		{"As Ah 2d 2c", "2-card 2-A"},
+		{"Ks 2s 3s 4s", "1-card 2"},
+		{"Ks Kh Kd Kc", "1-card K"},
+	}
+
+	for _, test := range tests {
+		handValue, err := EvaluateBadugiText(test.hand)
+		if err != nil {
+			t.Fatalf("Unable to evaluate %s: %v", test.hand, err)
+		}
+		got := BadugiDescription(handValue)
+		if got != test.want {
+			t.Fatalf("EvaluateBadugiText(%s) failed. Want %s but got %s", test.hand, test.want, got)
+		}
+	}
+
+	better, _ := EvaluateBadugiText("Ks Qh Jd Tc")
+	worse, _ := EvaluateBadugiText("As 2h 3d 3c")
+	if better <= worse {
+		t.Fatalf("A four card badugi beats any three card hand")
+	}
+
+	better, _ = EvaluateBadugiText("7s 5h 4d 2c")
+	worse, _ = EvaluateBadugiText("7s 6h 2d Ac")
+	if better <= worse {
+		t.Fatalf("7-5-4-2 beats 7-6-2-A")
+	}
+
+	if _, err := EvaluateBadugiText("As 2h 3d"); err == nil {
+		t.Fatalf("Expecting an error for a three card hand")
+	}
+}
+
+func TestEvaluateBadugiAllHands(t *testing.T) {
+	var sizes [5]int
+	count := 0
+	best := uint(0)
+	bestCount := 0
+
+	HandsRange2(4, func(mask uint64) {
+		handValue, err := EvaluateBadugi(mask)
+		if err != nil {
+			t.Fatalf("Unable to evaluate %s: %v", MaskToString(mask), err)
+		}
+		if BadugiDescription(handValue) == "" {
+			t.Fatalf("No description for %s", MaskToString(mask))
+		}
+
+		sizes[handValue>>BADUGI_SIZE_SHIFT]++
+		count++
+
+		if handValue > best {
+			best = handValue
+			bestCount = 0
+		}
+		if handValue == best {
+			bestCount++
+		}
+	})
+
+	if count != 270725 {
+		t.Fatalf("Incorrect number of hands. Want 270725, Got %d", count)
+	}
+
+	want := [5]int{0, 2873, 96252, 154440, 17160}
+	if sizes != want {
+		t.Fatalf("Incorrect number of hands per size. Want %v, Got %v", want, sizes)
+	}
+
+	if BadugiDescription(best) != "4-card 4-3-2-A badugi" || bestCount != 24 {
+		t.Fatalf("Incorrect best hand. Got %d of %s", bestCount, BadugiDescription(best))
+	}
+}
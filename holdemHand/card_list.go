@@ -0,0 +1,75 @@
+package holdemHand
+
+import (
+	"sort"
+)
+
+// Cards in a particular order, such as the order they were dealt or written in.
+// A hand mask can't keep the order of its cards.
+type CardList []int
+
+// The order cards are written in by CardFormatter
+type CardOrder int
+
+const (
+	// by suit, spades first, and by rank from the ace down, like MaskToString()
+	SuitRankOrder CardOrder = iota
+	// by rank from the ace down, then by suit
+	RankSuitOrder
+	// the order of the card list
+	InputOrder
+)
+
+// Returns the cards of a mask in SuitRankOrder, with the joker first.
+func MaskCards(mask uint64) CardList {
+	cards := make(CardList, 0, bitCount(mask))
+	if mask&JokerMask != 0 {
+		cards = append(cards, CardJoker)
+	}
+	for card := NumberOfCards - 1; card >= 0; card-- {
+		if mask&CardMasksTable[card] != 0 {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
+
+// The hand mask of the cards
+func (cards CardList) Mask() uint64 {
+	mask := uint64(0)
+	for _, card := range cards {
+		if card >= 0 && card <= CardJoker {
+			mask |= uint64(1) << card
+		}
+	}
+	return mask
+}
+
+// The cards in the order of the list
+func (cards CardList) String() string {
+	return CardFormatter{Order: InputOrder}.FormatList(cards)
+}
+
+// Returns the cards in this order, the list itself is left alone. The joker always comes first.
+func (order CardOrder) Sort(cards CardList) CardList {
+	if order == InputOrder {
+		return cards
+	}
+
+	sorted := append(CardList(nil), cards...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return order.key(sorted[i]) > order.key(sorted[j])
+	})
+	return sorted
+}
+
+// cards with a bigger key come first
+func (order CardOrder) key(card int) int {
+	if card == CardJoker {
+		return 1 << 16
+	}
+	if order == RankSuitOrder {
+		return (card%13)*4 + card/13
+	}
+	return card
+}
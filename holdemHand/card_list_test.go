@@ -0,0 +1,55 @@
+package holdemHand
+
+import (
+	"testing"
+)
+
+func TestCardOrder(t *testing.T) {
+	cards, err := ParseOptions{}.ParseList("2c As Kh Ac Xx")
+	if err != nil {
+		t.Fatalf("ParseList() failed: %v", err)
+	}
+
+	tests := []struct {
+		order CardOrder
+		want  string
+	}{
+		{SuitRankOrder, "Xx As Kh Ac 2c"},
+		{RankSuitOrder, "Xx As Ac Kh 2c"},
+		{InputOrder, "2c As Kh Ac Xx"},
+	}
+
+	for _, test := range tests {
+		if got := (CardFormatter{Order: test.order}).FormatList(cards); got != test.want {
+			t.Fatalf("Incorrect text in order %d. Want %q, Got %q", test.order, test.want, got)
+		}
+	}
+
+	if cards.String() != "2c As Kh Ac Xx" {
+		t.Fatalf("Incorrect card list text %q", cards.String())
+	}
+	if got := MaskToString(cards.Mask()); got != "Xx As Kh Ac 2c" {
+		t.Fatalf("Incorrect card list mask %q", got)
+	}
+	if got := (CardFormatter{Order: RankSuitOrder}).Format(cards.Mask()); got != "Xx As Ac Kh 2c" {
+		t.Fatalf("Incorrect mask text %q", got)
+	}
+}
+
+func TestFormatHand(t *testing.T) {
+	pocket, _ := ParseOptions{}.ParseList("7d As")
+	board, _ := ParseOptions{}.ParseList("Kh 2c Ac")
+
+	formatter := CardFormatter{Order: RankSuitOrder}
+	if got := formatter.FormatHand(pocket, board); got != "As 7d | Ac Kh 2c" {
+		t.Fatalf("Incorrect hand text %q", got)
+	}
+
+	formatter = CardFormatter{Order: InputOrder, GroupSeparator: " on "}
+	if got := formatter.FormatHand(pocket, board); got != "7d As on Kh 2c Ac" {
+		t.Fatalf("Incorrect hand text %q", got)
+	}
+	if got := formatter.FormatHand(pocket, nil); got != "7d As" {
+		t.Fatalf("Incorrect hand text without a board %q", got)
+	}
+}
@@ -0,0 +1,298 @@
+package holdemHand
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The notations accepted by ParseOptions.Parse() on top of the Ah style cards
+// read by ParseHand(). Cards can always be run together, as in AsKh.
+type ParseOptions struct {
+	// accept the suit symbols ♠♥♦♣ and ♤♡♢♧ as suits
+	UnicodeSuits bool
+	// characters allowed between cards as well as spaces, e.g. ",-/"
+	Separators string
+	// accept 10 as a ten
+	Ten bool
+	// accept cards written suit first, as in sA or ♠A
+	SuitFirst bool
+}
+
+// Accepts every notation
+var AnyNotation = ParseOptions{UnicodeSuits: true, Separators: ",;-/|", Ten: true, SuitFirst: true}
+
+// How cards are written by CardFormatter. The zero value writes the same text as MaskToString().
+type CardFormatter struct {
+	// write the suits as ♠♥♦♣
+	Unicode bool
+	// color the cards with ANSI escape codes, one color per suit
+	Color bool
+	// write 10 rather than T
+	Ten bool
+	// write the suit before the rank
+	SuitFirst bool
+	// written between cards, a space when empty
+	Separator string
+	// the order the cards are written in
+	Order CardOrder
+	// written between the pocket and the board by FormatHand(), " | " when empty
+	GroupSeparator string
+}
+
+const (
+	suitLetters = "cdhs"
+	ansiReset   = "\x1b[0m"
+)
+
+var (
+	unicodeSuits        = [4]rune{'♣', '♦', '♥', '♠'}
+	unicodeOutlineSuits = [4]rune{'♧', '♢', '♡', '♤'}
+	// a four color deck: green clubs, blue diamonds, red hearts and the default color for spades
+	ansiSuitColors = [4]string{"\x1b[32m", "\x1b[34m", "\x1b[31m", "\x1b[39m"}
+)
+
+// Parses a hand written in any of the notations allowed by the options and
+// returns its mask. Duplicated cards are an error.
+func (options ParseOptions) Parse(hand string) (uint64, error) {
+	mask := uint64(0)
+	err := options.parse(hand, func(card int, offset int) error {
+		if mask&(uint64(1)<<card) != 0 {
+			return fmt.Errorf("%w: %s is duplicated at offset %d", ErrBadHand, cardText(card), offset)
+		}
+		mask |= uint64(1) << card
+		return nil
+	})
+	return mask, err
+}
+
+// Same as Parse() but returns the cards in the order they are written.
+func (options ParseOptions) ParseList(hand string) (CardList, error) {
+	cards := CardList{}
+	mask := uint64(0)
+	err := options.parse(hand, func(card int, offset int) error {
+		if mask&(uint64(1)<<card) != 0 {
+			return fmt.Errorf("%w: %s is duplicated at offset %d", ErrBadHand, cardText(card), offset)
+		}
+		mask |= uint64(1) << card
+		cards = append(cards, card)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// Parses a single card, the whole string has to be the card.
+func (options ParseOptions) ParseCard(text string) (int, error) {
+	card := -1
+	err := options.parse(text, func(c int, offset int) error {
+		if card >= 0 {
+			return fmt.Errorf("%w: more than one card", ErrInvalidCard)
+		}
+		card = c
+		return nil
+	})
+	if err == nil && card < 0 {
+		err = ErrInvalidCard
+	}
+	return card, err
+}
+
+// calls callback with each card and its offset in the text
+func (options ParseOptions) parse(text string, callback func(card int, offset int) error) error {
+	for offset := 0; offset < len(text); {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == ' ' || strings.ContainsRune(options.Separators, r) {
+			offset += size
+			continue
+		}
+
+		card, size := options.nextCard(text[offset:])
+		if card < 0 {
+			return fmt.Errorf("%w at offset %d of %q", ErrInvalidCard, offset, text)
+		}
+		if err := callback(card, offset); err != nil {
+			return err
+		}
+		offset += size
+	}
+	return nil
+}
+
+// reads the card at the start of the text, returns the card and the number of bytes
+// it takes up or -1 when the text doesn't start with a card
+func (options ParseOptions) nextCard(text string) (int, int) {
+	// a joker is written as Xx or Jk, in any case
+	if len(text) >= 2 {
+		if joker := strings.ToLower(text[:2]); joker == "xx" || joker == "jk" {
+			return CardJoker, 2
+		}
+	}
+
+	if options.SuitFirst {
+		if suit, size := options.suit(text); suit >= 0 {
+			rank, rankSize := options.rank(text[size:])
+			if rank < 0 {
+				return -1, 0
+			}
+			return rank + 13*suit, size + rankSize
+		}
+	}
+
+	rank, size := options.rank(text)
+	if rank < 0 {
+		return -1, 0
+	}
+	suit, suitSize := options.suit(text[size:])
+	if suit < 0 {
+		return -1, 0
+	}
+	return rank + 13*suit, size + suitSize
+}
+
+func (options ParseOptions) rank(text string) (int, int) {
+	if text == "" {
+		return -1, 0
+	}
+
+	if options.Ten && strings.HasPrefix(text, "10") {
+		return RankTen, 2
+	}
+
+	if rank := strings.IndexByte("23456789TJQKA", text[0]); rank >= 0 {
+		return rank, 1
+	}
+	if rank := strings.IndexByte("23456789tjqka", text[0]); rank >= 0 {
+		return rank, 1
+	}
+	return -1, 0
+}
+
+func (options ParseOptions) suit(text string) (int, int) {
+	if text == "" {
+		return -1, 0
+	}
+
+	if suit := strings.IndexByte(suitLetters, text[0]|0x20); suit >= 0 {
+		return suit, 1
+	}
+
+	if !options.UnicodeSuits {
+		return -1, 0
+	}
+
+	r, size := utf8.DecodeRuneInString(text)
+	for suit := range unicodeSuits {
+		if r == unicodeSuits[suit] || r == unicodeOutlineSuits[suit] {
+			// the symbols are often followed by the emoji variation selector
+			if next, nextSize := utf8.DecodeRuneInString(text[size:]); next == '\uFE0F' {
+				size += nextSize
+			}
+			return suit, size
+		}
+	}
+	return -1, 0
+}
+
+// Writes the cards of a mask in the formatter's order. InputOrder is the same as
+// SuitRankOrder for a mask.
+func (f CardFormatter) Format(mask uint64) string {
+	return string(f.AppendMask(nil, mask))
+}
+
+// Same as Format() but appends the text to dst.
+func (f CardFormatter) AppendMask(dst []byte, mask uint64) []byte {
+	return f.AppendList(dst, MaskCards(mask))
+}
+
+// Writes a list of cards in the formatter's order.
+func (f CardFormatter) FormatList(cards CardList) string {
+	return string(f.AppendList(nil, cards))
+}
+
+// Same as FormatList() but appends the text to dst.
+func (f CardFormatter) AppendList(dst []byte, cards CardList) []byte {
+	for i, card := range f.Order.Sort(cards) {
+		if i > 0 {
+			dst = f.appendSeparator(dst)
+		}
+		dst = f.AppendCard(dst, card)
+	}
+	return dst
+}
+
+// Writes a player's pocket cards and the board, each in the formatter's order,
+// with the group separator between them. The separator is left out without a board.
+func (f CardFormatter) FormatHand(pocket CardList, board CardList) string {
+	dst := f.AppendList(nil, pocket)
+	if len(board) > 0 {
+		if f.GroupSeparator == "" {
+			dst = append(dst, " | "...)
+		} else {
+			dst = append(dst, f.GroupSeparator...)
+		}
+		dst = f.AppendList(dst, board)
+	}
+	return string(dst)
+}
+
+// Writes a single card
+func (f CardFormatter) FormatCard(card int) string {
+	return string(f.AppendCard(nil, card))
+}
+
+// Same as FormatCard() but appends the text to dst.
+func (f CardFormatter) AppendCard(dst []byte, card int) []byte {
+	if card == CardJoker {
+		return append(dst, JokerText...)
+	}
+	if card < 0 || card >= NumberOfCards {
+		return dst
+	}
+
+	rank, suit := card%13, card/13
+	if f.Color {
+		dst = append(dst, ansiSuitColors[suit]...)
+	}
+
+	if f.SuitFirst {
+		dst = f.appendSuit(dst, suit)
+	}
+	if f.Ten && rank == RankTen {
+		dst = append(dst, "10"...)
+	} else {
+		dst = append(dst, CardTable[card][0])
+	}
+	if !f.SuitFirst {
+		dst = f.appendSuit(dst, suit)
+	}
+
+	if f.Color {
+		dst = append(dst, ansiReset...)
+	}
+	return dst
+}
+
+func (f CardFormatter) appendSuit(dst []byte, suit int) []byte {
+	if f.Unicode {
+		return utf8.AppendRune(dst, unicodeSuits[suit])
+	}
+	return append(dst, suitLetters[suit])
+}
+
+func (f CardFormatter) appendSeparator(dst []byte) []byte {
+	if f.Separator == "" {
+		return append(dst, ' ')
+	}
+	return append(dst, f.Separator...)
+}
+
+// the text of a card as written by MaskToString()
+func cardText(card int) string {
+	if card == CardJoker {
+		return JokerText
+	}
+	return CardTable[card]
+}
@@ -0,0 +1,97 @@
+package holdemHand
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOptions(t *testing.T) {
+	want, _ := ParseHand("As Kh Td 2c")
+
+	tests := []struct {
+		options ParseOptions
+		hand    string
+		ok      bool
+	}{
+		{ParseOptions{}, "As Kh Td 2c", true},
+		{ParseOptions{}, "AsKhTd2c", true},
+		{ParseOptions{}, "as kh td 2C", true},
+		{ParseOptions{}, "As,Kh,Td,2c", false},
+		{ParseOptions{Separators: ",-"}, "As,Kh-Td, 2c", true},
+		{ParseOptions{}, "As Kh 10d 2c", false},
+		{ParseOptions{Ten: true}, "As Kh 10d 2c", true},
+		{ParseOptions{Ten: true}, "AsKh10d2c", true},
+		{ParseOptions{}, "A♠K♥T♦2♣", false},
+		{ParseOptions{UnicodeSuits: true}, "A♠K♥T♦2♣", true},
+		{ParseOptions{UnicodeSuits: true}, "A♤ K♡ T♢ 2♧", true},
+		{ParseOptions{UnicodeSuits: true}, "A♠️K♥️T♦️2♣️", true},
+		{ParseOptions{}, "sA hK dT c2", false},
+		{ParseOptions{SuitFirst: true}, "sA hK dT c2", true},
+		{AnyNotation, "♠A ♥K ♦10 ♣2", true},
+		{AnyNotation, "As Kh Td 2c 2c", false},
+		{AnyNotation, "As Kh Td 2", false},
+		{AnyNotation, "As Kh Td 1", false},
+	}
+
+	for _, test := range tests {
+		got, err := test.options.Parse(test.hand)
+		if test.ok && (err != nil || got != want) {
+			t.Fatalf("Parse(%q) with %+v = %s, %v", test.hand, test.options, MaskToString(got), err)
+		}
+		if !test.ok && err == nil {
+			t.Fatalf("Expecting Parse(%q) with %+v to fail", test.hand, test.options)
+		}
+	}
+
+	if _, err := AnyNotation.Parse("As As"); !errors.Is(err, ErrBadHand) {
+		t.Fatalf("Expecting ErrBadHand for a duplicated card, got %v", err)
+	}
+	if _, err := AnyNotation.Parse("As Zz"); !errors.Is(err, ErrInvalidCard) {
+		t.Fatalf("Expecting ErrInvalidCard, got %v", err)
+	}
+
+	joker, err := AnyNotation.Parse("Xx As")
+	if err != nil || joker != JokerMask|CardMasksTable[51] {
+		t.Fatalf("Incorrect joker hand %#x, %v", joker, err)
+	}
+
+	if card, err := AnyNotation.ParseCard("♥10"); err != nil || CardTable[card] != "Th" {
+		t.Fatalf("ParseCard(♥10) = %d, %v", card, err)
+	}
+	if _, err := AnyNotation.ParseCard("As Ks"); err == nil {
+		t.Fatal("Expecting ParseCard() to fail with two cards")
+	}
+}
+
+func TestCardFormatter(t *testing.T) {
+	mask, _ := ParseHand("As Th 2c")
+
+	tests := []struct {
+		formatter CardFormatter
+		want      string
+	}{
+		{CardFormatter{}, "As Th 2c"},
+		{CardFormatter{Unicode: true}, "A♠ T♥ 2♣"},
+		{CardFormatter{Ten: true, Separator: ","}, "As,10h,2c"},
+		{CardFormatter{SuitFirst: true, Unicode: true}, "♠A ♥T ♣2"},
+		{CardFormatter{Color: true}, "\x1b[39mAs\x1b[0m \x1b[31mTh\x1b[0m \x1b[32m2c\x1b[0m"},
+	}
+
+	for _, test := range tests {
+		if got := test.formatter.Format(mask); got != test.want {
+			t.Fatalf("Incorrect text with %+v. Want %q, Got %q", test.formatter, test.want, got)
+		}
+
+		// everything but the colors can be read back
+		if !test.formatter.Color {
+			if again, err := AnyNotation.Parse(test.formatter.Format(mask)); err != nil || again != mask {
+				t.Fatalf("Unable to parse %q back, %v", test.formatter.Format(mask), err)
+			}
+		}
+	}
+
+	all := FullDeck | JokerMask
+	if got := (CardFormatter{}).Format(all); got != MaskToString(all) {
+		t.Fatalf("The default formatter should match MaskToString(). Want %q, Got %q", MaskToString(all), got)
+	}
+}
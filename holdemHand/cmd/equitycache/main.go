@@ -0,0 +1,83 @@
+// Generates an equity cache file for heads-up matchups, see holdemHand.EquityCache.
+// Each argument is a matchup of two pockets separated by a dash. The cache is
+// filled in with every board of the given street and added to the output file
+// if it already exists.
+//
+//	go run ./cmd/equitycache -o equity.cache -street flop "As Ah-Ks Kh" "Ac Kc-Qd Qh"
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"holdemHand"
+)
+
+var streets = map[string]int{"preflop": 0, "flop": 3, "turn": 4, "river": 5}
+
+func main() {
+	output := flag.String("o", "equity.cache", "file to write the cache to")
+	street := flag.String("street", "preflop", "boards to generate: preflop, flop, turn or river")
+	flag.Parse()
+
+	boardCards, ok := streets[*street]
+	if !ok {
+		log.Fatalf("Unknown street %q", *street)
+	}
+
+	cache := holdemHand.NewEquityCache()
+	if data, err := os.ReadFile(*output); err == nil {
+		if cache, err = holdemHand.LoadEquityCache(bytes.NewReader(data)); err != nil {
+			log.Fatalf("%s: %v", *output, err)
+		}
+	} else if !os.IsNotExist(err) {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	for _, matchup := range flag.Args() {
+		hands := strings.Split(matchup, "-")
+		if len(hands) != 2 {
+			log.Fatalf("Bad matchup %q, expecting two pockets separated by a dash", matchup)
+		}
+
+		pocket1, err := holdemHand.ParseHand(hands[0])
+		if err != nil {
+			log.Fatalf("%s: %v", hands[0], err)
+		}
+		pocket2, err := holdemHand.ParseHand(hands[1])
+		if err != nil {
+			log.Fatalf("%s: %v", hands[1], err)
+		}
+
+		err = cache.Generate(ctx, pocket1, pocket2, boardCards, func(progress holdemHand.Progress) {
+			fmt.Fprintf(os.Stderr, "\r%s: %d/%d boards, %v left  ", matchup, progress.Processed, progress.Total,
+				progress.ETA.Round(time.Second))
+		})
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := cache.WriteTo(file); err != nil {
+		log.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "%d entries in %s\n", cache.Len(), *output)
+}
@@ -0,0 +1,26 @@
+// Generates holdem_tables.go, the lookup tables used by the hand evaluator.
+//
+//	go run ./cmd/gentables -o holdem_tables.go
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"holdemHand/internal/tables"
+)
+
+func main() {
+	output := flag.String("o", "holdem_tables.go", "file to write the tables to")
+	flag.Parse()
+
+	source, err := tables.Source()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*output, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,62 @@
+// Audits hand history files, replaying every hand and printing the problems found
+// (see handHistory.Hand.Audit()). Files ending in .ohh or .json are read as Open
+// Hand History, anything else as PokerStars text. Exits with status 1 when any
+// errors were found.
+//
+//	go run ./cmd/handaudit -warnings hands.txt server.ohh
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"holdemHand/handHistory"
+)
+
+func main() {
+	warnings := flag.Bool("warnings", false, "print warnings as well as errors")
+	flag.Parse()
+
+	hands, failed := 0, false
+	for _, name := range flag.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		reader := handHistory.NewReader(name, file)
+
+		for {
+			hand, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if handHistory.IsHandError(err) {
+				// carry on with the next hand
+				fmt.Printf("%s: %v\n", name, err)
+				failed = true
+				continue
+			}
+			if err != nil {
+				log.Fatalf("%s: %v", name, err)
+			}
+
+			hands++
+			for _, finding := range hand.Audit() {
+				if finding.Severity == handHistory.Error || *warnings {
+					fmt.Printf("%s: hand %s: %s\n", name, hand.ID, finding)
+				}
+				failed = failed || finding.Severity == handHistory.Error
+			}
+		}
+		file.Close()
+	}
+
+	fmt.Printf("%d hands audited\n", hands)
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,94 @@
+// Works out HUD statistics for every player in hand history files and writes them
+// as CSV (see handHistory.Stats). Files ending in .ohh or .json are read as Open
+// Hand History, anything else as PokerStars text. Hands that can't be read are
+// reported and left out.
+//
+//	go run ./cmd/handstats -positions CO,BTN -min-stack 40 -from 2023-01-01 hands.txt > stats.csv
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"holdemHand/handHistory"
+)
+
+func main() {
+	output := flag.String("o", "", "file to write the CSV to, standard output when empty")
+	positions := flag.String("positions", "", "comma separated positions to count: EP, MP, HJ, CO, BTN, SB or BB")
+	minStack := flag.Float64("min-stack", 0, "smallest starting stack to count, in big blinds")
+	maxStack := flag.Float64("max-stack", 0, "count only starting stacks below this many big blinds")
+	from := flag.String("from", "", "first date to count, as 2006-01-02")
+	to := flag.String("to", "", "count only hands before this date")
+	flag.Parse()
+
+	filter := handHistory.StatsFilter{MinStack: *minStack, MaxStack: *maxStack}
+	if *positions != "" {
+		for _, name := range strings.Split(*positions, ",") {
+			position := slices.Index(handHistory.PositionNames[:], strings.ToUpper(strings.TrimSpace(name)))
+			if position < 0 {
+				log.Fatalf("Unknown position %q", name)
+			}
+			filter.Positions = append(filter.Positions, handHistory.Position(position))
+		}
+	}
+	for _, date := range []struct {
+		text  string
+		value *time.Time
+	}{{*from, &filter.From}, {*to, &filter.To}} {
+		if date.text == "" {
+			continue
+		}
+		value, err := time.Parse(time.DateOnly, date.text)
+		if err != nil {
+			log.Fatal(err)
+		}
+		*date.value = value
+	}
+
+	stats := handHistory.NewStats(filter)
+	for _, name := range flag.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		reader := handHistory.NewReader(name, file)
+
+		for {
+			hand, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if handHistory.IsHandError(err) {
+				log.Printf("%s: %v", name, err)
+				continue
+			}
+			if err != nil {
+				log.Fatalf("%s: %v", name, err)
+			}
+			stats.Add(hand)
+		}
+		file.Close()
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		w = file
+	}
+	if err := stats.WriteCSV(w); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,62 @@
+// Generates the heads-up preflop equity of all 47008 distinct matchups, see
+// holdemHand.PreflopTable. The output is an equity cache file. Generation can be
+// interrupted with Ctrl-C, the matchups done so far are written out and the
+// next run carries on from there.
+//
+//	go run ./cmd/preflopequity -o preflop.cache
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"holdemHand"
+)
+
+func main() {
+	output := flag.String("o", "preflop.cache", "file to write the matchups to")
+	workers := flag.Int("workers", 0, "number of worker goroutines, one per CPU when zero")
+	flag.Parse()
+
+	cache := holdemHand.NewEquityCache()
+	if data, err := os.ReadFile(*output); err == nil {
+		if cache, err = holdemHand.LoadEquityCache(bytes.NewReader(data)); err != nil {
+			log.Fatalf("%s: %v", *output, err)
+		}
+	} else if !os.IsNotExist(err) {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := cache.GeneratePreflop(ctx, holdemHand.ParallelOptions{
+		Workers: *workers,
+		Progress: func(progress holdemHand.Progress) {
+			fmt.Fprintf(os.Stderr, "\r%d/%d matchups, %v left  ", progress.Processed, progress.Total,
+				progress.ETA.Round(time.Second))
+		},
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := cache.WriteTo(file); err != nil {
+		log.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "%d matchups in %s\n", cache.Len(), *output)
+}
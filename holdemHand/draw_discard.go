@@ -0,0 +1,122 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+)
+
+// The possible outcomes of one way to discard from a five card draw hand
+type DrawOption struct {
+	Discard uint64
+	Keep    uint64
+	// number of ways to draw the replacement cards
+	Draws uint64
+	// number of draws that end up as each hand type, indexed by hand type
+	HandTypes [StraightFlush + 1]uint64
+	// number of draws that beat the target hand value
+	Wins uint64
+	// number of draws that tie it
+	Ties uint64
+}
+
+// The chance of drawing to a hand better than the target
+func (option DrawOption) WinProbability() float64 {
+	return float64(option.Wins) / float64(option.Draws)
+}
+
+// The chance of drawing to a hand equal to the target
+func (option DrawOption) TieProbability() float64 {
+	return float64(option.Ties) / float64(option.Draws)
+}
+
+// The chance of drawing to the given hand type
+func (option DrawOption) HandTypeProbability(handType int) float64 {
+	return float64(option.HandTypes[handType]) / float64(option.Draws)
+}
+
+// Works out every one of the 32 ways to discard from a five card hand by drawing
+// each possible set of replacement cards from the rest of the deck. Dead cards,
+// such as cards seen or discarded by other players, are removed from the deck.
+// The target is the hand value (see EvaluateMask()) the final hand has to beat.
+// The first option is to stand pat, the last is to draw five new cards.
+func AnalyzeDraw(hand uint64, dead uint64, target uint) ([]DrawOption, error) {
+	return AnalyzeDrawContext(context.Background(), hand, dead, target, nil)
+}
+
+// Same as AnalyzeDraw() but stops early when the context is cancelled, returning the
+// context's error. progress, which can be nil, is called every so often with the
+// number of draws worked out so far for all the options.
+func AnalyzeDrawContext(ctx context.Context, hand uint64, dead uint64, target uint,
+	progress func(Progress)) ([]DrawOption, error) {
+	if bitCount(hand) != 5 || hand>>NumberOfCards != 0 {
+		return nil, errors.New("Five card draw hands have five cards")
+	}
+
+	if hand&dead != 0 {
+		return nil, errors.New("Bad dead cards definition")
+	}
+
+	var cards [5]uint64
+	count := 0
+	for a := NumberOfCards - 1; a >= 0; a-- {
+		if hand&CardMasksTable[a] != 0 {
+			cards[count] = CardMasksTable[a]
+			count++
+		}
+	}
+
+	// discarded cards can't be drawn again
+	deck := deckCards(FullDeck &^ (hand | dead))
+	total := uint64(0)
+	for discards := 0; discards < 32; discards++ {
+		total += Binomial(len(deck), bits.OnesCount(uint(discards)))
+	}
+	tracker := newProgressTracker(ctx, progress, total)
+
+	options := make([]DrawOption, 32)
+	for discards := 0; discards < 32; discards++ {
+		option := &options[discards]
+		for i := 0; i < 5; i++ {
+			if discards&(1<<i) != 0 {
+				option.Discard |= cards[i]
+			}
+		}
+		option.Keep = hand &^ option.Discard
+
+		handsRangeCardsUntil(deck, option.Keep, 5-bits.OnesCount64(option.Keep), func(mask uint64) bool {
+			option.Draws++
+			option.HandTypes[EvaluateType(mask)]++
+
+			value, _ := EvaluateMask(mask)
+			if value > target {
+				option.Wins++
+			} else if value == target {
+				option.Ties++
+			}
+			return tracker.step()
+		})
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	tracker.done()
+
+	return options, nil
+}
+
+// Returns the option with the best chance of beating the target, split pots
+// count as half a win.
+func BestDraw(options []DrawOption) DrawOption {
+	best := DrawOption{}
+	bestScore := -1.0
+	for _, option := range options {
+		score := option.WinProbability() + option.TieProbability()/2
+		if score > bestScore {
+			best = option
+			bestScore = score
+		}
+	}
+	return best
+}
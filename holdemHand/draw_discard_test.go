@@ -0,0 +1,53 @@
+package holdemHand
+
+import (
+	"testing"
+)
+
+func TestAnalyzeDraw(t *testing.T) {
+	hand, _ := ParseHand("As Ks Qs Js 2c")
+	target, _ := EvaluateHandText("Ah Ad 7c 5d 3h")
+	options, err := AnalyzeDraw(hand, 0, target)
+	if err != nil {
+		t.Fatalf("AnalyzeDraw() failed: %v", err)
+	}
+
+	if len(options) != 32 {
+		t.Fatalf("Expecting 32 discard options, got %d", len(options))
+	}
+
+	if options[0].Discard != 0 || options[0].Draws != 1 || options[0].Wins != 0 {
+		t.Fatalf("Standing pat with ace high should lose to a pair of aces")
+	}
+
+	draws := uint64(0)
+	for _, option := range options {
+		draws += option.Draws
+	}
+	// every five card hand can be drawn exactly once
+	if draws != 2598960 {
+		t.Fatalf("Incorrect number of draws. Want 2598960, Got %d", draws)
+	}
+
+	deuce, _ := ParseHand("2c")
+	var flushDraw DrawOption
+	for _, option := range options {
+		if option.Discard == deuce {
+			flushDraw = option
+		}
+	}
+
+	want := [StraightFlush + 1]uint64{23, 12, 0, 0, 3, 8, 0, 0, 1}
+	if flushDraw.Draws != 47 || flushDraw.HandTypes != want {
+		t.Fatalf("Incorrect hand types for the flush draw. Want %v, Got %v", want, flushDraw.HandTypes)
+	}
+
+	best := BestDraw(options)
+	if best.Discard != deuce {
+		t.Fatalf("Expecting to discard the 2c, got %s", MaskToString(best.Discard))
+	}
+
+	if _, err := AnalyzeDraw(hand, deuce, target); err == nil {
+		t.Fatalf("Expecting an error when the hand contains dead cards")
+	}
+}
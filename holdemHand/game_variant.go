@@ -0,0 +1,198 @@
+package holdemHand
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// All 52 cards, without the joker
+const FullDeck uint64 = (uint64(1) << NumberOfCards) - 1
+
+// The rule deciding which cards make up a player's hand
+type HandSelection struct {
+	// number of cards that make up the final hand
+	HandSize int
+	// how many of the pocket cards can be used in the final hand,
+	// the rest come from the board
+	MinPocketCards int
+	MaxPocketCards int
+}
+
+// A poker game variant. Equity, enumeration and description code is written
+// against this interface so a new game only has to describe its deck, its deal
+// and how its hands are evaluated.
+type Game interface {
+	Name() string
+	// the cards in the deck as a hand mask
+	Deck() uint64
+	// the number of cards dealt to each player
+	PocketCards() int
+	// the number of community cards dealt on each street, empty without a board
+	BoardCards() []int
+	HandSelection() HandSelection
+	// Evaluates a player's hand, a higher value is a better hand. GameBestHand() calls
+	// it with the cards the hand selection rule allows.
+	EvaluateHigh(pocket uint64, board uint64) (uint, error)
+	// Evaluates a player's low hand for split pot games, a higher value is a better low.
+	// ok is false when the game has no low or the hand doesn't qualify.
+	EvaluateLow(pocket uint64, board uint64) (value uint, ok bool)
+	// Converts a value returned by EvaluateHigh into descriptive text
+	Describe(handValue uint) string
+}
+
+// Texas hold'em
+type HoldemGame struct {
+	// evaluates the hands, EvaluateMask() is used when nil
+	Evaluator Evaluator
+}
+
+var Holdem Game = HoldemGame{}
+
+func (HoldemGame) Name() string      { return "Hold'em" }
+func (HoldemGame) Deck() uint64      { return FullDeck }
+func (HoldemGame) PocketCards() int  { return 2 }
+func (HoldemGame) BoardCards() []int { return []int{3, 1, 1} }
+func (HoldemGame) HandSelection() HandSelection {
+	return HandSelection{HandSize: 5, MinPocketCards: 0, MaxPocketCards: 2}
+}
+
+func (game HoldemGame) EvaluateHigh(pocket uint64, board uint64) (uint, error) {
+	if game.Evaluator != nil {
+		return game.Evaluator.EvaluateMask(pocket | board)
+	}
+	return EvaluateMask(pocket | board)
+}
+
+func (HoldemGame) EvaluateLow(pocket uint64, board uint64) (uint, bool) {
+	return 0, false
+}
+
+func (HoldemGame) Describe(handValue uint) string {
+	return HandDescriptionFromHandType(handValue)
+}
+
+// Five card draw, optionally played with the joker as the bug
+type FiveCardDrawGame struct {
+	Joker bool
+}
+
+var FiveCardDraw Game = FiveCardDrawGame{}
+
+func (game FiveCardDrawGame) Name() string {
+	if game.Joker {
+		return "Five card draw with the bug"
+	}
+	return "Five card draw"
+}
+
+func (game FiveCardDrawGame) Deck() uint64 {
+	if game.Joker {
+		return FullDeck | JokerMask
+	}
+	return FullDeck
+}
+
+func (FiveCardDrawGame) PocketCards() int  { return 5 }
+func (FiveCardDrawGame) BoardCards() []int { return nil }
+func (FiveCardDrawGame) HandSelection() HandSelection {
+	return HandSelection{HandSize: 5, MinPocketCards: 5, MaxPocketCards: 5}
+}
+
+func (FiveCardDrawGame) EvaluateHigh(pocket uint64, board uint64) (uint, error) {
+	return EvaluateMaskWithJoker(pocket|board, JokerBug)
+}
+
+func (FiveCardDrawGame) EvaluateLow(pocket uint64, board uint64) (uint, bool) {
+	return 0, false
+}
+
+func (FiveCardDrawGame) Describe(handValue uint) string {
+	return HandDescriptionFromHandType(handValue)
+}
+
+// Badugi, the best hand is the one with the most cards of different suits and ranks
+type BadugiGame struct{}
+
+var Badugi Game = BadugiGame{}
+
+func (BadugiGame) Name() string      { return "Badugi" }
+func (BadugiGame) Deck() uint64      { return FullDeck }
+func (BadugiGame) PocketCards() int  { return 4 }
+func (BadugiGame) BoardCards() []int { return nil }
+func (BadugiGame) HandSelection() HandSelection {
+	return HandSelection{HandSize: 4, MinPocketCards: 1, MaxPocketCards: 4}
+}
+
+func (BadugiGame) EvaluateHigh(pocket uint64, board uint64) (uint, error) {
+	return EvaluateBadugi(pocket | board)
+}
+
+func (BadugiGame) EvaluateLow(pocket uint64, board uint64) (uint, bool) {
+	return 0, false
+}
+
+func (BadugiGame) Describe(handValue uint) string {
+	return BadugiDescription(handValue)
+}
+
+// The total number of community cards in a game
+func GameBoardSize(game Game) int {
+	size := 0
+	for _, cards := range game.BoardCards() {
+		size += cards
+	}
+	return size
+}
+
+// Evaluates a player's best hand under the game's hand selection rule: the best
+// HandSize card hand using MinPocketCards to MaxPocketCards of the pocket cards and
+// the rest from the board.
+func GameBestHand(game Game, pocket uint64, board uint64) (uint, error) {
+	return bestHand(game, game.HandSelection(), pocket, board)
+}
+
+func bestHand(game Game, selection HandSelection, pocket uint64, board uint64) (uint, error) {
+	pocketCards, boardCards := bits.OnesCount64(pocket), bits.OnesCount64(board)
+
+	// when the rule allows any mix of the cards the game's evaluator picks the best hand
+	if selection.MinPocketCards <= max(0, selection.HandSize-boardCards) &&
+		selection.MaxPocketCards >= min(pocketCards, selection.HandSize) {
+		return game.EvaluateHigh(pocket, board)
+	}
+
+	best, found := uint(0), false
+	var err error
+	for used := selection.MinPocketCards; used <= min(selection.MaxPocketCards, pocketCards); used++ {
+		fromBoard := selection.HandSize - used
+		if fromBoard < 0 || fromBoard > boardCards {
+			continue
+		}
+		DeckHandsRange(pocket, 0, 0, used, func(pocketPart uint64) {
+			DeckHandsRange(board, 0, 0, fromBoard, func(boardPart uint64) {
+				value, e := game.EvaluateHigh(pocketPart, boardPart)
+				if e != nil {
+					err = e
+				} else if value > best || !found {
+					best, found = value, true
+				}
+			})
+		})
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("%w: no %d card hand can be made from the cards", ErrBadHand, selection.HandSize)
+	}
+	return best, nil
+}
+
+// Evaluates a player's best hand and converts it into descriptive text
+func GameHandDescription(game Game, pocket uint64, board uint64) (string, error) {
+	handValue, err := GameBestHand(game, pocket, board)
+	if err != nil {
+		return "", err
+	}
+	return game.Describe(handValue), nil
+}
@@ -0,0 +1,115 @@
+package holdemHand
+
+import (
+	"errors"
+	"math/bits"
+	"testing"
+)
+
+// hold'em where the lowest pocket card wins half the pot
+type lowCardHoldem struct {
+	HoldemGame
+}
+
+func (lowCardHoldem) EvaluateLow(pocket uint64, board uint64) (uint, bool) {
+	ranks := (pocket | pocket>>13 | pocket>>26 | pocket>>39) & 0x1FFF
+	return uint(13 - bits.TrailingZeros64(ranks)), true
+}
+
+// hold'em with four pocket cards where exactly two of them play, like omaha
+type twoOfFourHoldem struct {
+	HoldemGame
+}
+
+func (twoOfFourHoldem) PocketCards() int { return 4 }
+func (twoOfFourHoldem) HandSelection() HandSelection {
+	return HandSelection{HandSize: 5, MinPocketCards: 2, MaxPocketCards: 2}
+}
+
+func TestGameBestHand(t *testing.T) {
+	pocket, _ := ParseHand("As Ks Qs Js")
+	board, _ := ParseHand("Ts 9s 2h 3d 4c")
+
+	// with two pocket cards and three from the board there is no flush or straight
+	got, err := GameHandDescription(twoOfFourHoldem{}, pocket, board)
+	if err != nil || got != "High card: Ace" {
+		t.Fatalf("Incorrect description %q, %v", got, err)
+	}
+	// hold'em plays any five of the seven cards
+	holdemPocket, _ := ParseHand("Qs Js")
+	holdemBoard, _ := ParseHand("As Ks Ts 2h 3d")
+	if value, _ := GameBestHand(Holdem, holdemPocket, holdemBoard); getHandType(value) != StraightFlush {
+		t.Fatalf("Expecting a straight flush, got %#x", value)
+	}
+
+	trips, _ := ParseHand("2c 2d 7h 8h")
+	results, err := GameHandEquity(twoOfFourHoldem{}, []uint64{pocket, trips}, board, 0)
+	if err != nil || results[1].Equity != 1 {
+		t.Fatalf("Trip twos beat ace high, got %+v, %v", results, err)
+	}
+
+	if _, err := GameBestHand(twoOfFourHoldem{}, pocket, 0); !errors.Is(err, ErrBadHand) {
+		t.Fatalf("Expecting ErrBadHand without a board, got %v", err)
+	}
+}
+
+func TestGameEquity(t *testing.T) {
+	aces, _ := ParseHand("As Ah")
+	kings, _ := ParseHand("Kd Kc")
+	flop, _ := ParseHand("2c 7d Jh")
+
+	want, _ := HandEquity([]uint64{aces, kings}, flop, 0)
+	got, err := GameHandEquity(Holdem, []uint64{aces, kings}, flop, 0)
+	if err != nil {
+		t.Fatalf("GameHandEquity() failed: %v", err)
+	}
+	if got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("Hold'em equity should not depend on the entry point")
+	}
+
+	board, _ := ParseHand("2c 7d Jh 9s 3c")
+	results, _ := GameHandEquity(lowCardHoldem{}, []uint64{aces, kings}, board, 0)
+	if results[0].Equity != 0.5 || results[0].Ties != 1 {
+		t.Fatalf("Aces win the high half and kings win the low half. Got %f", results[0].Equity)
+	}
+}
+
+func TestBadugiEquity(t *testing.T) {
+	hero, _ := ParseHand("As 2h 3d 4c")
+	villain, _ := ParseHand("Ks Kh Kd Kc")
+	results, err := GameHandEquity(Badugi, []uint64{hero, villain}, 0, 0)
+	if err != nil {
+		t.Fatalf("GameHandEquity() failed: %v", err)
+	}
+	if results[0].Total != 1 || results[0].Wins != 1 {
+		t.Fatalf("The best badugi beats a one card hand")
+	}
+
+	pair, _ := ParseHand("As Ah")
+	if _, err := GameHandEquity(Badugi, []uint64{hero, pair}, 0, 0); err == nil {
+		t.Fatalf("Expecting an error for a two card badugi hand")
+	}
+}
+
+func TestDeckHandsRange(t *testing.T) {
+	game := FiveCardDrawGame{Joker: true}
+	count := 0
+	jokers := 0
+	DeckHandsRange(game.Deck(), 0, 0, 5, func(mask uint64) {
+		count++
+		if mask&JokerMask != 0 {
+			jokers++
+		}
+	})
+
+	// choose 5 from 53 cards, and 4 from 52 with the joker
+	if count != 2869685 || jokers != 270725 {
+		t.Fatalf("Incorrect number of hands. Got %d hands, %d with the joker", count, jokers)
+	}
+
+	hand, _ := ParseHand("As Ah Ad Ac Xx")
+	got, _ := GameHandDescription(game, hand, 0)
+	if got != "Five of a kind, Ace's" {
+		t.Fatalf("Incorrect description. Got %s", got)
+	}
+}
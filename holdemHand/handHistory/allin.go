@@ -0,0 +1,183 @@
+package handHistory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"holdemHand"
+)
+
+// The players' equity at the moment the betting ended with a player all in before
+// the river, and what each player could expect to win from it.
+type AllInEquity struct {
+	// the street the betting ended on
+	Street Street
+	// the index in Hand.Actions of the action that ended the betting
+	Action int
+	// the board when the players were all in
+	Board   holdemHand.CardList
+	Pots    []AllInPot
+	Players []AllInPlayer
+}
+
+// A pot and the equity in it of each of the players who can win it
+type AllInPot struct {
+	Pot
+	// in the same order as the players
+	Equities []float64
+}
+
+// What a player put in, won and could expect to win in an all in hand
+type AllInPlayer struct {
+	Player    string
+	HoleCards holdemHand.CardList
+	// the equity in the main pot
+	Equity   float64
+	Invested Amount
+	Won      Amount
+	// the winnings the player could expect from their equity in each pot, less a share of the rake
+	Expected Amount
+}
+
+var (
+	ErrNoAllIn      = errors.New("Nobody was all in before the river")
+	ErrUnknownCards = errors.New("Hole cards aren't known")
+)
+
+// What the player actually made from the hand
+func (player AllInPlayer) Net() Amount {
+	return player.Won - player.Invested
+}
+
+// What the player would have made winning their equity of each pot, the all in
+// adjusted result
+func (player AllInPlayer) ExpectedNet() Amount {
+	return player.Expected - player.Invested
+}
+
+// Works out each player's equity when the betting ended with a player all in
+// before the river, by enumerating every board that completes the board dealt so
+// far. Every player left in the hand needs known hole cards; the known cards of
+// the players who folded are taken out of the deck. Returns ErrNoAllIn when the
+// hand wasn't all in before the river. Only hold'em is supported.
+func (hand *Hand) AllInEquity() (*AllInEquity, error) {
+	if !strings.HasPrefix(hand.Game, "Hold'em") {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, hand.Game)
+	}
+
+	// find the action after which nobody could bet any more
+	allIn := -1
+	street := Preflop
+	hand.Replay(func(index int, state *ReplayState) bool {
+		if state.Street >= River || len(state.inHand()) < 2 || len(state.ToAct) > 0 || len(state.canAct()) > 1 {
+			return true
+		}
+		for _, name := range state.inHand() {
+			if state.AllIn[name] {
+				allIn, street = index, state.Street
+				return false
+			}
+		}
+		return true
+	})
+	if allIn < 0 {
+		return nil, ErrNoAllIn
+	}
+
+	result := &AllInEquity{Street: street, Action: allIn, Board: hand.BoardAt(street)}
+	board := result.Board.Mask()
+	if want := [...]int{0, 3, 4}[street]; len(result.Board) != want {
+		return nil, fmt.Errorf("%w: the board is incomplete", holdemHand.ErrBadHand)
+	}
+
+	pockets := map[string]uint64{}
+	active := hand.ActivePlayers()
+	known := uint64(0)
+	for _, player := range hand.Players {
+		if len(player.HoleCards) == 2 {
+			pockets[player.Name] = player.HoleCards.Mask()
+			known |= pockets[player.Name]
+		} else if len(player.HoleCards) > 0 {
+			return nil, fmt.Errorf("%w: %s has %d cards", holdemHand.ErrBadHand, player.Name, len(player.HoleCards))
+		}
+	}
+	for _, name := range active {
+		if _, ok := pockets[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCards, name)
+		}
+	}
+
+	// the rake comes out of every pot in proportion
+	total := Amount(0)
+	pots := hand.SidePots()
+	for _, pot := range pots {
+		total += pot.Amount
+	}
+	share := 1.0
+	if total > 0 {
+		share = float64(total-hand.Rake) / float64(total)
+	}
+
+	expected := map[string]float64{}
+	for _, pot := range pots {
+		allInPot := AllInPot{Pot: pot, Equities: []float64{1}}
+		if len(pot.Players) > 1 {
+			potPockets := []uint64{}
+			for _, name := range pot.Players {
+				potPockets = append(potPockets, pockets[name])
+			}
+			dead := known
+			for _, pocket := range potPockets {
+				dead &^= pocket
+			}
+
+			results, err := holdemHand.HandEquity(potPockets, board, dead)
+			if err != nil {
+				return nil, err
+			}
+			allInPot.Equities = make([]float64, len(results))
+			for i, r := range results {
+				allInPot.Equities[i] = r.Equity
+			}
+		}
+
+		for i, name := range pot.Players {
+			expected[name] += allInPot.Equities[i] * float64(pot.Amount) * share
+		}
+		result.Pots = append(result.Pots, allInPot)
+	}
+
+	for _, player := range hand.Players {
+		if !hand.dealtIn(player.Name) {
+			continue
+		}
+		allInPlayer := AllInPlayer{
+			Player:    player.Name,
+			HoleCards: player.HoleCards,
+			Invested:  hand.Invested(player.Name),
+			Won:       hand.Won(player.Name),
+			Expected:  Amount(math.Round(expected[player.Name])),
+		}
+		if len(result.Pots) > 0 {
+			for i, name := range result.Pots[0].Players {
+				if name == player.Name {
+					allInPlayer.Equity = result.Pots[0].Equities[i]
+				}
+			}
+		}
+		result.Players = append(result.Players, allInPlayer)
+	}
+	return result, nil
+}
+
+// The player in the result, nil when they weren't in the hand
+func (equity *AllInEquity) Player(name string) *AllInPlayer {
+	for i := range equity.Players {
+		if equity.Players[i].Player == name {
+			return &equity.Players[i]
+		}
+	}
+	return nil
+}
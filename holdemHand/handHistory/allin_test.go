@@ -0,0 +1,99 @@
+package handHistory
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAllInEquity(t *testing.T) {
+	hand := readSampleHands(t)[1]
+
+	equity, err := hand.AllInEquity()
+	if err != nil {
+		t.Fatalf("AllInEquity() failed: %v", err)
+	}
+	if equity.Street != Preflop || equity.Action != 8 || len(equity.Board) != 0 || len(equity.Pots) != 2 {
+		t.Fatalf("Incorrect all in %+v", equity)
+	}
+
+	for _, pot := range equity.Pots {
+		sum := 0.0
+		for _, e := range pot.Equities {
+			sum += e
+		}
+		if math.Abs(sum-1) > 1e-9 || len(pot.Equities) != len(pot.Players) {
+			t.Fatalf("Equities don't add up %+v", pot)
+		}
+	}
+
+	expected := Amount(0)
+	for _, player := range equity.Players {
+		expected += player.Expected
+	}
+	if len(equity.Players) != 3 || math.Abs(float64(expected-700000)) > 2 {
+		t.Fatalf("Incorrect expected winnings %v", expected)
+	}
+
+	// QQ is ahead of AK in the side pot, more so with an ace dead
+	bob := equity.Player("Bob")
+	if bob.Net() != 100000 || bob.Equity <= 0 || equity.Pots[1].Equities[0] < 0.55 || bob.ExpectedNet() >= bob.Net() {
+		t.Fatalf("Incorrect result for Bob %+v", bob)
+	}
+	if alice := equity.Player("Alice"); alice.Won != 300000 || alice.Equity > 0.5 {
+		t.Fatalf("Incorrect result for Alice %+v", alice)
+	}
+
+	if _, err := readSampleHands(t)[0].AllInEquity(); !errors.Is(err, ErrNoAllIn) {
+		t.Fatalf("Expecting ErrNoAllIn, got %v", err)
+	}
+	hand.Player("Carol").HoleCards = nil
+	if _, err := hand.AllInEquity(); !errors.Is(err, ErrUnknownCards) {
+		t.Fatalf("Expecting ErrUnknownCards, got %v", err)
+	}
+}
+
+func TestSessionReport(t *testing.T) {
+	report := NewSessionReport()
+	for _, hand := range readSampleHands(t) {
+		if _, err := report.Add(hand); err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+	}
+
+	if report.Hands != 4 || report.AllInHands != 1 || report.Skipped != 0 {
+		t.Fatalf("Incorrect counts %+v", report)
+	}
+	alice := report.Players["Alice"]
+	if alice.Hands != 3 || alice.AllInHands != 1 || alice.Net != -170+200000-5 || alice.AllInNet != 200000 {
+		t.Fatalf("Incorrect result for Alice %+v", alice)
+	}
+	if alice.AdjustedNet() != alice.Net-alice.Luck() {
+		t.Fatalf("Incorrect adjusted net for Alice %+v", alice)
+	}
+
+	total := Amount(0)
+	for _, result := range report.Results() {
+		total += result.AllInExpectedNet
+	}
+	if math.Abs(float64(total)) > 2 {
+		t.Fatalf("The all in EV doesn't add up to zero: %v", total)
+	}
+
+	merged := NewSessionReport()
+	merged.Merge(report)
+	merged.Merge(report)
+	if merged.Hands != 8 || merged.Players["Alice"].AllInNet != 400000 || len(merged.Players) != len(report.Players) {
+		t.Fatalf("Incorrect merged report %+v", merged)
+	}
+
+	var text strings.Builder
+	if err := report.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(text.String()), "\n"); len(lines) != 2+len(report.Players) ||
+		lines[0] != "4 hands, 1 all in, 0 skipped" {
+		t.Fatalf("Incorrect report\n%s", text.String())
+	}
+}
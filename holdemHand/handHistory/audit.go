@@ -0,0 +1,220 @@
+package handHistory
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"holdemHand"
+)
+
+// How serious a finding is
+type Severity int
+
+const (
+	// something odd that doesn't change the result, such as a missing all in flag
+	Warning Severity = iota
+	// an illegal action or a wrong result
+	Error
+)
+
+var SeverityNames = [...]string{"warning", "error"}
+
+// What is wrong
+type FindingKind int
+
+const (
+	UnknownPlayerFinding FindingKind = iota
+	OutOfTurn
+	IllegalAction
+	BetTooSmall
+	BetTooLarge
+	// a player put in more than their stack
+	OverStack
+	AllInMismatch
+	// the street ended with players still to act
+	IncompleteRound
+	WrongUncalledBet
+	// the pots don't add up
+	PotMismatch
+	// a pot went to someone other than the best hand
+	WrongWinner
+	// the same card was dealt twice
+	DuplicateCard
+)
+
+var FindingNames = [...]string{
+	"unknown player", "out of turn", "illegal action", "bet too small", "bet too large", "over stack",
+	"all in mismatch", "incomplete round", "wrong uncalled bet", "pot mismatch", "wrong winner", "duplicate card"}
+
+// A problem found auditing a hand
+type Finding struct {
+	Severity Severity
+	Kind     FindingKind
+	Street   Street
+	// empty when the finding isn't about one player
+	Player string
+	// the index in Hand.Actions of the action the finding is about, -1 for the hand as a whole
+	Action int
+	// the line of the hand history, zero when not known
+	Line    int
+	Message string
+}
+
+// A pot and the players who can win it
+type Pot struct {
+	Amount Amount
+	// the players who didn't fold and put in enough to win the pot, in seat order
+	Players []string
+}
+
+func (severity Severity) String() string {
+	if severity < 0 || int(severity) >= len(SeverityNames) {
+		return ""
+	}
+	return SeverityNames[severity]
+}
+
+func (kind FindingKind) String() string {
+	if kind < 0 || int(kind) >= len(FindingNames) {
+		return ""
+	}
+	return FindingNames[kind]
+}
+
+// Writes the finding as "line 12: error: out of turn: Bob acts before Alice"
+func (finding Finding) String() string {
+	text := fmt.Sprintf("%s: %s: %s", finding.Severity, finding.Kind, finding.Message)
+	if finding.Line > 0 {
+		return fmt.Sprintf("line %d: %s", finding.Line, text)
+	}
+	return text
+}
+
+func newFinding(severity Severity, kind FindingKind, index int, action Action, format string, args ...any) Finding {
+	return Finding{
+		Severity: severity,
+		Kind:     kind,
+		Street:   action.Street,
+		Player:   action.Player,
+		Action:   index,
+		Line:     action.Line,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Replays the hand with Replay() and then checks the cards and the result: no card
+// is dealt twice, the pots add up to the total pot and the winnings plus the rake,
+// and in hold'em each pot went to the best of the hands shown by the players who
+// could win it, evaluated with EvaluateMask(). Pots where nobody showed are not checked.
+func (hand *Hand) Audit() []Finding {
+	findings := hand.Replay(nil)
+	report := func(kind FindingKind, player string, format string, args ...any) {
+		finding := newFinding(Error, kind, -1, Action{Street: Showdown, Player: player}, format, args...)
+		finding.Line = hand.Line
+		findings = append(findings, finding)
+	}
+
+	dealt := append(holdemHand.CardList{}, hand.Board...)
+	for _, player := range hand.Players {
+		dealt = append(dealt, player.HoleCards...)
+	}
+	seen := uint64(0)
+	for _, card := range dealt {
+		if mask := uint64(1) << card; seen&mask != 0 {
+			report(DuplicateCard, "", "%s was dealt twice", holdemHand.Card(card))
+		} else {
+			seen |= mask
+		}
+	}
+
+	pots := hand.SidePots()
+	total := Amount(0)
+	for _, pot := range pots {
+		total += pot.Amount
+	}
+	won := hand.Rake
+	for _, winning := range hand.Winnings {
+		won += winning.Amount
+		if winning.Pot >= len(pots) {
+			report(PotMismatch, winning.Player, "%s won pot %d of %d", winning.Player, winning.Pot+1, len(pots))
+		}
+	}
+	if hand.TotalPot != 0 && hand.TotalPot != total {
+		report(PotMismatch, "", "the total pot is %s, the bets add up to %s", hand.TotalPot, total)
+	}
+	if won != total {
+		report(PotMismatch, "", "%s was won and raked from a pot of %s", won, total)
+	}
+
+	for number, pot := range pots {
+		winners := hand.potWinners(number)
+		if len(pot.Players) == 1 {
+			if len(winners) > 0 && !slices.Equal(winners, pot.Players) {
+				report(WrongWinner, "", "pot %d went to %s rather than %s", number+1,
+					strings.Join(winners, ", "), pot.Players[0])
+			}
+			continue
+		}
+		if !strings.HasPrefix(hand.Game, "Hold'em") || len(hand.Board) != 5 {
+			continue
+		}
+
+		shown, err := hand.shownHands(pot.Players)
+		if err != nil || len(shown) == 0 {
+			continue
+		}
+		if best := bestPlayers(shown); !slices.Equal(best, winners) {
+			report(WrongWinner, "", "pot %d went to %s but %s won with %s", number+1,
+				strings.Join(winners, ", "), strings.Join(best, ", "), holdemHand.HandValue(shown[0].Value))
+		}
+	}
+	return findings
+}
+
+// Works out the main pot and the side pots from what each player put in. Each pot
+// goes up to the all in amount of a player who didn't fold, and holds the chips of
+// the players who folded up to that amount too. The main pot is first.
+func (hand *Hand) SidePots() []Pot {
+	invested := map[string]Amount{}
+	order := []string{}
+	for _, player := range hand.Players {
+		if hand.dealtIn(player.Name) {
+			invested[player.Name] = hand.Invested(player.Name)
+			order = append(order, player.Name)
+		}
+	}
+	active := hand.ActivePlayers()
+
+	levels := []Amount{}
+	for _, name := range active {
+		if !slices.Contains(levels, invested[name]) {
+			levels = append(levels, invested[name])
+		}
+	}
+	slices.Sort(levels)
+
+	pots := []Pot{}
+	previous := Amount(0)
+	for _, level := range levels {
+		pot := Pot{Players: []string{}}
+		for _, name := range order {
+			pot.Amount += max(0, min(invested[name], level)-previous)
+			if invested[name] >= level && slices.Contains(active, name) {
+				pot.Players = append(pot.Players, name)
+			}
+		}
+		if pot.Amount > 0 {
+			pots = append(pots, pot)
+		}
+		previous = level
+	}
+
+	// chips folded above the biggest all in go in the last pot
+	for _, name := range order {
+		if extra := invested[name] - previous; extra > 0 && len(pots) > 0 {
+			pots[len(pots)-1].Amount += extra
+		}
+	}
+	return pots
+}
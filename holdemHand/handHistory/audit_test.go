@@ -0,0 +1,121 @@
+package handHistory
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func hasFinding(findings []Finding, kind FindingKind) bool {
+	for _, finding := range findings {
+		if finding.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAuditCleanHands(t *testing.T) {
+	hands := readSampleHands(t)
+
+	file, err := os.Open("testdata/sample.ohh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	imported, err := ParseOHH(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, hand := range append(hands, imported...) {
+		if findings := hand.Audit(); len(findings) > 0 {
+			t.Fatalf("Unexpected findings for hand %s: %v", hand.ID, findings)
+		}
+	}
+}
+
+func TestAuditFindings(t *testing.T) {
+	tests := []struct {
+		name   string
+		hand   int
+		change func(hand *Hand)
+		kind   FindingKind
+	}{
+		{"out of turn", 0, func(hand *Hand) {
+			hand.Actions[2], hand.Actions[3] = hand.Actions[3], hand.Actions[2]
+		}, OutOfTurn},
+		{"check facing a bet", 0, func(hand *Hand) { hand.Actions[12].Type, hand.Actions[12].Amount = Check, 0 }, IllegalAction},
+		{"small raise", 0, func(hand *Hand) { hand.Actions[3].Amount = 15 }, BetTooSmall},
+		{"pot limit", 0, func(hand *Hand) {
+			hand.Game = "Hold'em Pot Limit"
+			hand.Actions[11].Amount = 500
+		}, BetTooLarge},
+		{"over stack", 1, func(hand *Hand) { hand.Players[0].Stack = 90000 }, OverStack},
+		{"all in flag", 1, func(hand *Hand) { hand.Actions[7].AllIn = false }, AllInMismatch},
+		{"incomplete round", 0, func(hand *Hand) { hand.Actions = append(hand.Actions[:10], hand.Actions[11:]...) }, IncompleteRound},
+		{"uncalled bet", 2, func(hand *Hand) { hand.Actions = append(hand.Actions[:3], hand.Actions[4:]...) }, WrongUncalledBet},
+		{"wrong uncalled bet", 1, func(hand *Hand) { hand.Actions[9].Amount = 100000 }, WrongUncalledBet},
+		{"total pot", 0, func(hand *Hand) { hand.TotalPot = 400 }, PotMismatch},
+		{"rake", 0, func(hand *Hand) { hand.Rake = 10 }, PotMismatch},
+		{"main pot winner", 1, func(hand *Hand) { hand.Winnings[1].Player = "Carol" }, WrongWinner},
+		{"side pot winner", 1, func(hand *Hand) { hand.Winnings[0].Player = "Carol" }, WrongWinner},
+		{"uncontested pot", 2, func(hand *Hand) { hand.Winnings[0].Player = "Alice" }, WrongWinner},
+		{"duplicate card", 0, func(hand *Hand) { hand.Player("Carol").HoleCards[0] = hand.Board[0] }, DuplicateCard},
+	}
+
+	for _, test := range tests {
+		hand := readSampleHands(t)[test.hand]
+		test.change(hand)
+		if findings := hand.Audit(); !hasFinding(findings, test.kind) {
+			t.Fatalf("Expecting a %s finding for %s, got %v", test.kind, test.name, findings)
+		}
+	}
+
+	hand := readSampleHands(t)[0]
+	hand.Actions[2], hand.Actions[3] = hand.Actions[3], hand.Actions[2]
+	finding := hand.Replay(nil)[0]
+	if finding.Line != 12 || finding.Player != "Alice" || finding.Action != 2 || finding.Severity != Error {
+		t.Fatalf("Incorrect finding %+v", finding)
+	}
+	if finding.String() != "line 12: error: out of turn: Alice acts before Dan:2" {
+		t.Fatalf("Incorrect finding text %q", finding.String())
+	}
+}
+
+func TestReplay(t *testing.T) {
+	hand := readSampleHands(t)[1]
+
+	var stacks map[string]Amount
+	pots := []Amount{}
+	findings := hand.Replay(func(index int, state *ReplayState) bool {
+		stacks = state.Stacks
+		pots = append(pots, state.Pot)
+		return true
+	})
+	if len(findings) > 0 {
+		t.Fatalf("Unexpected findings %v", findings)
+	}
+	if want := map[string]Amount{"Alice": 0, "Bob": 0, "Carol": 200000, "Erin": 250000}; !reflect.DeepEqual(stacks, want) {
+		t.Fatalf("Incorrect stacks %v", stacks)
+	}
+	if len(pots) != len(hand.Actions) || pots[4] != 18000 || pots[len(pots)-1] != 700000 {
+		t.Fatalf("Incorrect pots %v", pots)
+	}
+
+	// stop after the first raise
+	var state *ReplayState
+	hand.Replay(func(index int, s *ReplayState) bool {
+		state = s
+		return hand.Actions[index].Type != Raise
+	})
+	if state.CurrentBet != 30000 || state.MinRaise != 20000 || len(state.ToAct) != 2 || state.ToAct["Bob"] {
+		t.Fatalf("Incorrect state after the raise %+v", state)
+	}
+
+	sidePots := hand.SidePots()
+	if len(sidePots) != 2 || sidePots[0].Amount != 300000 || sidePots[1].Amount != 400000 ||
+		len(sidePots[0].Players) != 3 || !reflect.DeepEqual(sidePots[1].Players, []string{"Bob", "Carol"}) {
+		t.Fatalf("Incorrect side pots %+v", sidePots)
+	}
+}
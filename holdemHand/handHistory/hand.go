@@ -0,0 +1,273 @@
+// Package handHistory reads poker hand histories into a structured model and
+// analyses them with the holdemHand evaluator.
+package handHistory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"holdemHand"
+)
+
+// A chip amount in hundredths, cents for cash games. Tournament chips are
+// whole numbers so a tournament stack of 1500 is 150000.
+type Amount int64
+
+// The betting rounds of a hand
+type Street int
+
+const (
+	Preflop Street = iota
+	Flop
+	Turn
+	River
+	Showdown
+)
+
+var StreetNames = [...]string{"Preflop", "Flop", "Turn", "River", "Showdown"}
+
+// What a player did
+type ActionType int
+
+const (
+	PostSmallBlind ActionType = iota
+	PostBigBlind
+	PostAnte
+	// a dead blind or straddle posted on top of the normal blinds
+	PostBlind
+	Fold
+	Check
+	Call
+	Bet
+	Raise
+	// the part of a bet nobody called, given back to the player
+	UncalledBet
+	Show
+	Muck
+)
+
+var ActionNames = [...]string{
+	"posts small blind", "posts big blind", "posts the ante", "posts blind", "folds", "checks",
+	"calls", "bets", "raises", "uncalled bet", "shows", "mucks"}
+
+// One action in a hand
+type Action struct {
+	Street Street
+	Player string
+	Type   ActionType
+	// the chips the player put in with this action, or got back for an uncalled bet
+	Amount Amount
+	// the player's total bet on the street after a bet or raise
+	To    Amount
+	AllIn bool
+	// shown cards
+	Cards holdemHand.CardList
+	// the line of the hand history the action was read from, zero when not read from text
+	Line int
+}
+
+// A player in a hand
+type Player struct {
+	Seat  int
+	Name  string
+	Stack Amount
+	// empty when the cards weren't seen
+	HoleCards  holdemHand.CardList
+	SittingOut bool
+}
+
+// Chips won from a pot
+type Winning struct {
+	Player string
+	Amount Amount
+	// zero for the main pot, one for the first side pot and so on
+	Pot int
+}
+
+// A hand as recorded in a hand history
+type Hand struct {
+	Site string
+	ID   string
+	// empty for cash games
+	Tournament string
+	// such as Hold'em No Limit
+	Game       string
+	Currency   string
+	SmallBlind Amount
+	BigBlind   Amount
+	Ante       Amount
+	// when the hand started, in UTC
+	Time     time.Time
+	Table    string
+	MaxSeats int
+	// the seat of the button
+	Button int
+	// in seat order
+	Players []Player
+	// the player whose hole cards were dealt face up to the history's owner
+	Hero     string
+	Actions  []Action
+	Board    holdemHand.CardList
+	Winnings []Winning
+	TotalPot Amount
+	Rake     Amount
+	// the first line of the hand in the hand history
+	Line int
+}
+
+var ErrUnknownPlayer = errors.New("Unknown player")
+
+// Reads an amount such as $1,234.50, €0.05 or 1500
+func ParseAmount(text string) (Amount, error) {
+	text = strings.TrimLeft(text, "$€£")
+	text = strings.ReplaceAll(text, ",", "")
+
+	whole, fraction, found := strings.Cut(text, ".")
+	if whole == "" || (found && (len(fraction) == 0 || len(fraction) > 2)) {
+		return 0, fmt.Errorf("Invalid amount %q", text)
+	}
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units < 0 {
+		return 0, fmt.Errorf("Invalid amount %q", text)
+	}
+	cents, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil || cents < 0 {
+		return 0, fmt.Errorf("Invalid amount %q", text)
+	}
+	return Amount(units*100 + cents), nil
+}
+
+// Writes the amount with two decimals, or none for whole amounts
+func (a Amount) String() string {
+	sign := ""
+	if a < 0 {
+		sign, a = "-", -a
+	}
+	if a%100 == 0 {
+		return fmt.Sprintf("%s%d", sign, a/100)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, a/100, a%100)
+}
+
+// The amount in whole units, such as dollars
+func (a Amount) Float() float64 {
+	return float64(a) / 100
+}
+
+// An amount is written to JSON as a number in whole units, such as 1.25
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// Reads a number in whole units, rounded to hundredths
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var value float64
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*a = Amount(math.Round(value * 100))
+	return nil
+}
+
+func (street Street) String() string {
+	if street < 0 || int(street) >= len(StreetNames) {
+		return ""
+	}
+	return StreetNames[street]
+}
+
+func (action ActionType) String() string {
+	if action < 0 || int(action) >= len(ActionNames) {
+		return ""
+	}
+	return ActionNames[action]
+}
+
+// Returns the player with the given name, nil when there isn't one
+func (hand *Hand) Player(name string) *Player {
+	for i := range hand.Players {
+		if hand.Players[i].Name == name {
+			return &hand.Players[i]
+		}
+	}
+	return nil
+}
+
+// The board cards dealt by the given street, nothing before the flop
+func (hand *Hand) BoardAt(street Street) holdemHand.CardList {
+	cards := 0
+	switch street {
+	case Preflop:
+	case Flop:
+		cards = 3
+	case Turn:
+		cards = 4
+	default:
+		cards = 5
+	}
+	return hand.Board[:min(cards, len(hand.Board))]
+}
+
+// The total won by a player, over all the pots
+func (hand *Hand) Won(player string) Amount {
+	won := Amount(0)
+	for _, winning := range hand.Winnings {
+		if winning.Player == player {
+			won += winning.Amount
+		}
+	}
+	return won
+}
+
+// The chips a player put into the pot, less any uncalled bet given back
+func (hand *Hand) Invested(player string) Amount {
+	invested := Amount(0)
+	for _, action := range hand.Actions {
+		if action.Player != player {
+			continue
+		}
+		if action.Type == UncalledBet {
+			invested -= action.Amount
+		} else {
+			invested += action.Amount
+		}
+	}
+	return invested
+}
+
+// The players who didn't fold, in seat order
+func (hand *Hand) ActivePlayers() []string {
+	folded := map[string]bool{}
+	for _, action := range hand.Actions {
+		if action.Type == Fold {
+			folded[action.Player] = true
+		}
+	}
+
+	players := []string{}
+	for _, player := range hand.Players {
+		if !player.SittingOut && !folded[player.Name] && hand.dealtIn(player.Name) {
+			players = append(players, player.Name)
+		}
+	}
+	return players
+}
+
+// true when the player took part in the hand
+func (hand *Hand) dealtIn(player string) bool {
+	for _, action := range hand.Actions {
+		if action.Player == player {
+			return true
+		}
+	}
+	return false
+}
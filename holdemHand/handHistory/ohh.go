@@ -0,0 +1,491 @@
+package handHistory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"holdemHand"
+)
+
+// The version of the Open Hand History standard written by WriteOHH()
+const OHHSpecVersion = "1.4.6"
+
+// A hand in the Open Hand History JSON format. Cards are read and written by the
+// holdemHand card parser and formatter, and amounts are numbers in whole units.
+type OHHHand struct {
+	SpecVersion    string             `json:"spec_version"`
+	SiteName       string             `json:"site_name"`
+	NetworkName    string             `json:"network_name,omitempty"`
+	GameNumber     string             `json:"game_number"`
+	StartDateUTC   string             `json:"start_date_utc,omitempty"`
+	TableName      string             `json:"table_name"`
+	GameType       string             `json:"game_type"`
+	BetLimit       OHHBetLimit        `json:"bet_limit"`
+	TableSize      int                `json:"table_size"`
+	Currency       string             `json:"currency,omitempty"`
+	DealerSeat     int                `json:"dealer_seat"`
+	SmallBlind     Amount             `json:"small_blind_amount"`
+	BigBlind       Amount             `json:"big_blind_amount"`
+	Ante           Amount             `json:"ante_amount"`
+	HeroPlayerID   *int               `json:"hero_player_id,omitempty"`
+	Tournament     bool               `json:"tournament"`
+	TournamentInfo *OHHTournamentInfo `json:"tournament_info,omitempty"`
+	Players        []OHHPlayer        `json:"players"`
+	Rounds         []OHHRound         `json:"rounds"`
+	Pots           []OHHPot           `json:"pots"`
+}
+
+type OHHBetLimit struct {
+	// NL, PL or FL
+	BetType string `json:"bet_type"`
+}
+
+type OHHTournamentInfo struct {
+	TournamentNumber string `json:"tournament_number"`
+}
+
+type OHHPlayer struct {
+	ID            int    `json:"id"`
+	Seat          int    `json:"seat"`
+	Name          string `json:"name"`
+	StartingStack Amount `json:"starting_stack"`
+	SittingOut    bool   `json:"is_sitting_out,omitempty"`
+}
+
+// The actions on one street and the board cards dealt for it
+type OHHRound struct {
+	ID      int                 `json:"id"`
+	Street  string              `json:"street"`
+	Cards   holdemHand.CardList `json:"cards,omitempty"`
+	Actions []OHHAction         `json:"actions"`
+}
+
+type OHHAction struct {
+	ActionNumber int    `json:"action_number"`
+	PlayerID     int    `json:"player_id"`
+	Action       string `json:"action"`
+	// the chips put in by the action
+	Amount Amount              `json:"amount,omitempty"`
+	AllIn  bool                `json:"is_allin,omitempty"`
+	Cards  holdemHand.CardList `json:"cards,omitempty"`
+}
+
+type OHHPot struct {
+	// zero for the main pot
+	Number     int            `json:"number"`
+	Amount     Amount         `json:"amount"`
+	Rake       Amount         `json:"rake,omitempty"`
+	PlayerWins []OHHPlayerWin `json:"player_wins"`
+}
+
+type OHHPlayerWin struct {
+	PlayerID  int    `json:"player_id"`
+	WinAmount Amount `json:"win_amount"`
+}
+
+var ErrBadOHH = errors.New("Invalid open hand history")
+
+// A hand in an OHH stream that can't be read, counting the hands from 1
+type OHHError struct {
+	Hand int
+	Err  error
+}
+
+func (e *OHHError) Error() string {
+	return fmt.Sprintf("hand %d: %v", e.Hand, e.Err)
+}
+
+func (e *OHHError) Unwrap() error {
+	return e.Err
+}
+
+// the OHH names of the actions, UncalledBet isn't written as OHH leaves it out
+var ohhActionNames = map[ActionType]string{
+	PostSmallBlind: "Post SB",
+	PostBigBlind:   "Post BB",
+	PostAnte:       "Post Ante",
+	PostBlind:      "Post Extra Blind",
+	Fold:           "Fold",
+	Check:          "Check",
+	Call:           "Call",
+	Bet:            "Bet",
+	Raise:          "Raise",
+	Show:           "Shows Cards",
+	Muck:           "Mucks Cards",
+}
+
+var (
+	ohhGameTypes  = map[string]string{"Hold'em": "Holdem", "Omaha": "Omaha", "Omaha Hi/Lo": "OmahaHiLo"}
+	ohhBetTypes   = map[string]string{"No Limit": "NL", "Pot Limit": "PL", "Limit": "FL"}
+	ohhStreetCard = map[Street][2]int{Flop: {0, 3}, Turn: {3, 4}, River: {4, 5}}
+)
+
+// Reads a stream of OHH hands, each one a JSON object holding the hand under "ohh",
+// as written by WriteOHH(). A hand that can't be read is skipped after returning an
+// *OHHError, so reading can carry on with the next hand. A JSON syntax error ends
+// the stream.
+type OHHReader struct {
+	decoder *json.Decoder
+	hands   int
+}
+
+func NewOHHReader(r io.Reader) *OHHReader {
+	return &OHHReader{decoder: json.NewDecoder(r)}
+}
+
+// Reads every hand, stopping at the first error.
+func ParseOHH(r io.Reader) ([]*Hand, error) {
+	reader := NewOHHReader(r)
+	hands := []*Hand{}
+	for {
+		hand, err := reader.Next()
+		if err == io.EOF {
+			return hands, nil
+		}
+		if err != nil {
+			return hands, err
+		}
+		hands = append(hands, hand)
+	}
+}
+
+// Returns the next hand, or io.EOF when there are no more.
+func (r *OHHReader) Next() (*Hand, error) {
+	var file struct {
+		OHH *OHHHand `json:"ohh"`
+	}
+	err := r.decoder.Decode(&file)
+	var syntaxError *json.SyntaxError
+	if err == io.EOF || err == io.ErrUnexpectedEOF || errors.As(err, &syntaxError) {
+		return nil, err
+	}
+	r.hands++
+
+	// the decoder has read the whole object even when it couldn't be decoded
+	if err != nil {
+		return nil, &OHHError{Hand: r.hands, Err: fmt.Errorf("%w: %v", ErrBadOHH, err)}
+	}
+	if file.OHH == nil {
+		return nil, &OHHError{Hand: r.hands, Err: fmt.Errorf("%w: no ohh object", ErrBadOHH)}
+	}
+	hand, err := file.OHH.Hand()
+	if err != nil {
+		return nil, &OHHError{Hand: r.hands, Err: err}
+	}
+	return hand, nil
+}
+
+// Writes hands in the OHH format, each one a JSON object holding the hand under
+// "ohh", with a blank line between them.
+func WriteOHH(w io.Writer, hands ...*Hand) error {
+	for _, hand := range hands {
+		ohh, err := hand.OHH()
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(map[string]*OHHHand{"ohh": ohh}, "", "  ")
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(data, '\n', '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Converts the hand to the OHH format. Players are numbered by their seats. The
+// uncalled bets are left out as OHH works them out from the bets.
+func (hand *Hand) OHH() (*OHHHand, error) {
+	game, limit, _ := strings.Cut(hand.Game, " ")
+	if strings.HasPrefix(hand.Game, "Omaha Hi/Lo ") {
+		game, limit = "Omaha Hi/Lo", strings.TrimPrefix(hand.Game, "Omaha Hi/Lo ")
+	}
+	if ohhGameTypes[game] == "" || ohhBetTypes[limit] == "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, hand.Game)
+	}
+
+	ohh := &OHHHand{
+		SpecVersion: OHHSpecVersion,
+		SiteName:    hand.Site,
+		GameNumber:  hand.ID,
+		TableName:   hand.Table,
+		GameType:    ohhGameTypes[game],
+		BetLimit:    OHHBetLimit{ohhBetTypes[limit]},
+		TableSize:   hand.MaxSeats,
+		Currency:    hand.Currency,
+		DealerSeat:  hand.Button,
+		SmallBlind:  hand.SmallBlind,
+		BigBlind:    hand.BigBlind,
+		Ante:        hand.Ante,
+		Tournament:  hand.Tournament != "",
+		Players:     []OHHPlayer{},
+		Rounds:      []OHHRound{},
+		Pots:        []OHHPot{},
+	}
+	if !hand.Time.IsZero() {
+		ohh.StartDateUTC = hand.Time.UTC().Format(time.RFC3339)
+	}
+	if hand.Tournament != "" {
+		ohh.TournamentInfo = &OHHTournamentInfo{hand.Tournament}
+	}
+
+	seats := map[string]int{}
+	for _, player := range hand.Players {
+		seats[player.Name] = player.Seat
+		ohh.Players = append(ohh.Players, OHHPlayer{
+			ID:            player.Seat,
+			Seat:          player.Seat,
+			Name:          player.Name,
+			StartingStack: player.Stack,
+			SittingOut:    player.SittingOut,
+		})
+	}
+	if hero := hand.Player(hand.Hero); hero != nil {
+		ohh.HeroPlayerID = &hero.Seat
+	}
+
+	// a round for every street with actions or board cards
+	number := 0
+	for street := Preflop; street <= Showdown; street++ {
+		round := OHHRound{ID: len(ohh.Rounds), Street: street.String(), Actions: []OHHAction{}}
+		if cards, ok := ohhStreetCard[street]; ok && len(hand.Board) >= cards[1] {
+			round.Cards = hand.Board[cards[0]:cards[1]]
+		}
+
+		if hero := hand.Player(hand.Hero); street == Preflop && hero != nil && len(hero.HoleCards) > 0 {
+			number++
+			round.Actions = append(round.Actions,
+				OHHAction{ActionNumber: number, PlayerID: hero.Seat, Action: "Dealt Cards", Cards: hero.HoleCards})
+		}
+
+		for _, action := range hand.Actions {
+			if action.Street != street || action.Type == UncalledBet {
+				continue
+			}
+			seat, ok := seats[action.Player]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownPlayer, action.Player)
+			}
+
+			number++
+			ohhAction := OHHAction{
+				ActionNumber: number,
+				PlayerID:     seat,
+				Action:       ohhActionNames[action.Type],
+				Amount:       action.Amount,
+				AllIn:        action.AllIn,
+				Cards:        action.Cards,
+			}
+			if action.Type == Muck {
+				ohhAction.Cards = hand.Player(action.Player).HoleCards
+			}
+			round.Actions = append(round.Actions, ohhAction)
+		}
+
+		if len(round.Actions) > 0 || len(round.Cards) > 0 {
+			ohh.Rounds = append(ohh.Rounds, round)
+		}
+	}
+
+	// the main pot holds whatever isn't in the side pots
+	pots := map[int]*OHHPot{0: {Number: 0, Rake: hand.Rake, PlayerWins: []OHHPlayerWin{}}}
+	sidePots := Amount(0)
+	for _, winning := range hand.Winnings {
+		pot := pots[winning.Pot]
+		if pot == nil {
+			pot = &OHHPot{Number: winning.Pot, PlayerWins: []OHHPlayerWin{}}
+			pots[winning.Pot] = pot
+		}
+		pot.PlayerWins = append(pot.PlayerWins, OHHPlayerWin{seats[winning.Player], winning.Amount})
+		if winning.Pot != 0 {
+			pot.Amount += winning.Amount
+			sidePots += winning.Amount
+		}
+	}
+	pots[0].Amount = hand.TotalPot - sidePots
+	for number := 0; len(ohh.Pots) < len(pots); number++ {
+		if pot, ok := pots[number]; ok {
+			ohh.Pots = append(ohh.Pots, *pot)
+		}
+	}
+	return ohh, nil
+}
+
+// Converts an OHH hand to a Hand. The uncalled bet, if any, is worked out from the
+// bets and added after the last bet or fold.
+func (ohh *OHHHand) Hand() (*Hand, error) {
+	game, limit := "", ""
+	for name, ohhName := range ohhGameTypes {
+		if ohhName == ohh.GameType {
+			game = name
+		}
+	}
+	for name, ohhName := range ohhBetTypes {
+		if ohhName == ohh.BetLimit.BetType {
+			limit = name
+		}
+	}
+	if game == "" || limit == "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnsupported, ohh.GameType, ohh.BetLimit.BetType)
+	}
+
+	hand := &Hand{
+		Site:       ohh.SiteName,
+		ID:         ohh.GameNumber,
+		Game:       game + " " + limit,
+		Currency:   ohh.Currency,
+		SmallBlind: ohh.SmallBlind,
+		BigBlind:   ohh.BigBlind,
+		Ante:       ohh.Ante,
+		Table:      ohh.TableName,
+		MaxSeats:   ohh.TableSize,
+		Button:     ohh.DealerSeat,
+	}
+	if ohh.TournamentInfo != nil {
+		hand.Tournament = ohh.TournamentInfo.TournamentNumber
+	}
+	if ohh.StartDateUTC != "" {
+		start, err := time.Parse(time.RFC3339, ohh.StartDateUTC)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrBadOHH, err)
+		}
+		hand.Time = start.UTC()
+	}
+
+	names := map[int]string{}
+	for _, player := range ohh.Players {
+		names[player.ID] = player.Name
+		hand.Players = append(hand.Players, Player{
+			Seat:       player.Seat,
+			Name:       player.Name,
+			Stack:      player.StartingStack,
+			SittingOut: player.SittingOut,
+		})
+	}
+	if ohh.HeroPlayerID != nil {
+		hand.Hero = names[*ohh.HeroPlayerID]
+	}
+
+	actionTypes := map[string]ActionType{}
+	for actionType, name := range ohhActionNames {
+		actionTypes[name] = actionType
+	}
+
+	board := map[Street]holdemHand.CardList{}
+	for _, round := range ohh.Rounds {
+		street := Street(-1)
+		for s := Preflop; s <= Showdown; s++ {
+			if strings.EqualFold(round.Street, s.String()) {
+				street = s
+			}
+		}
+		if street < 0 {
+			return nil, fmt.Errorf("%w: unknown street %q", ErrBadOHH, round.Street)
+		}
+		board[street] = append(board[street], round.Cards...)
+
+		// each player's bet on the street
+		contributions := map[string]Amount{}
+		for _, ohhAction := range round.Actions {
+			name, ok := names[ohhAction.PlayerID]
+			if !ok {
+				return nil, fmt.Errorf("%w: player %d", ErrUnknownPlayer, ohhAction.PlayerID)
+			}
+
+			if ohhAction.Action == "Dealt Cards" || ohhAction.Action == "Mucks Cards" || ohhAction.Action == "Shows Cards" {
+				if len(ohhAction.Cards) > 0 {
+					hand.Player(name).HoleCards = ohhAction.Cards
+				}
+			}
+			actionType, ok := actionTypes[ohhAction.Action]
+			if !ok {
+				// dealt cards, players sitting down and so on
+				continue
+			}
+
+			action := Action{
+				Street: street,
+				Player: name,
+				Type:   actionType,
+				Amount: ohhAction.Amount,
+				AllIn:  ohhAction.AllIn,
+			}
+			if actionType == Show {
+				action.Cards = ohhAction.Cards
+			}
+			if actionType != PostAnte {
+				contributions[name] += hand.liveAmount(action)
+			}
+			if actionType == Bet || actionType == Raise {
+				action.To = contributions[name]
+			}
+			hand.Actions = append(hand.Actions, action)
+		}
+	}
+
+	for _, street := range []Street{Flop, Turn, River} {
+		if cards := ohhStreetCard[street]; len(board[street]) > 0 {
+			if len(hand.Board) != cards[0] || len(board[street]) != cards[1]-cards[0] {
+				return nil, fmt.Errorf("%w: the board is out of order", ErrBadOHH)
+			}
+			hand.Board = append(hand.Board, board[street]...)
+		}
+	}
+
+	for _, pot := range ohh.Pots {
+		hand.TotalPot += pot.Amount
+		hand.Rake += pot.Rake
+		for _, win := range pot.PlayerWins {
+			name, ok := names[win.PlayerID]
+			if !ok {
+				return nil, fmt.Errorf("%w: player %d", ErrUnknownPlayer, win.PlayerID)
+			}
+			hand.Winnings = append(hand.Winnings, Winning{Player: name, Amount: win.WinAmount, Pot: pot.Number})
+		}
+	}
+
+	hand.addUncalledBet()
+	return hand, nil
+}
+
+// adds the part of the biggest bet nobody called, given back to the player after
+// the last bet or fold
+func (hand *Hand) addUncalledBet() {
+	bets := map[string]Amount{}
+	last := -1
+	for i, action := range hand.Actions {
+		if action.Type != PostAnte && action.Type != Show && action.Type != Muck {
+			bets[action.Player] += hand.liveAmount(action)
+			last = i
+		}
+	}
+
+	top, second, player := Amount(0), Amount(0), ""
+	for name, bet := range bets {
+		if bet > top {
+			top, second, player = bet, top, name
+		} else if bet > second {
+			second = bet
+		}
+	}
+	if last < 0 || top == second {
+		return
+	}
+
+	uncalled := Action{Street: hand.Actions[last].Street, Player: player, Type: UncalledBet, Amount: top - second}
+	hand.Actions = append(hand.Actions[:last+1], append([]Action{uncalled}, hand.Actions[last+1:]...)...)
+}
+
+// the part of the action's chips that counts towards the player's bet, a small
+// blind posted dead with the big blind only counts as the big blind
+func (hand *Hand) liveAmount(action Action) Amount {
+	if action.Type == PostBlind && action.Amount > hand.BigBlind && action.Amount < 2*hand.BigBlind {
+		return hand.BigBlind
+	}
+	return action.Amount
+}
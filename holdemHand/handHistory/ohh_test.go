@@ -0,0 +1,139 @@
+package handHistory
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestOHHRoundTrip(t *testing.T) {
+	hands := readSampleHands(t)
+
+	var buffer bytes.Buffer
+	if err := WriteOHH(&buffer, hands...); err != nil {
+		t.Fatalf("WriteOHH() failed: %v", err)
+	}
+	read, err := ParseOHH(&buffer)
+	if err != nil {
+		t.Fatalf("ParseOHH() failed: %v", err)
+	}
+	if len(read) != len(hands) {
+		t.Fatalf("Expecting %d hands, got %d", len(hands), len(read))
+	}
+
+	for i, hand := range hands {
+		// OHH has no line numbers and groups the winnings by pot
+		hand.Line = 0
+		for a := range hand.Actions {
+			hand.Actions[a].Line = 0
+		}
+		slices.SortStableFunc(hand.Winnings, func(a, b Winning) int { return a.Pot - b.Pot })
+		if !reflect.DeepEqual(hand, read[i]) {
+			t.Fatalf("Hand %d changed.\nWant %+v\nGot  %+v", i, hand, read[i])
+		}
+	}
+
+	shown, err := read[1].VerifyShowdown()
+	if err != nil || shown[0].Player != "Alice" {
+		t.Fatalf("Incorrect showdown %v, %v", shown, err)
+	}
+}
+
+func TestOHHImport(t *testing.T) {
+	file, err := os.Open("testdata/sample.ohh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	hands, err := ParseOHH(file)
+	if err != nil || len(hands) != 1 {
+		t.Fatalf("ParseOHH() failed: %v", err)
+	}
+	hand := hands[0]
+
+	if hand.Game != "Hold'em No Limit" || hand.Hero != "Hero" || hand.Player("Hero").HoleCards.String() != "Jh Jc" {
+		t.Fatalf("Incorrect hand %+v", hand)
+	}
+	if hand.Board.String() != "Jd 8s 3h 2c" || hand.Player("Villain").Stack != 2550 || hand.Time.Hour() != 18 {
+		t.Fatalf("Incorrect board or players %+v", hand)
+	}
+	if hand.TotalPot != 720 || hand.Rake != 30 || hand.Won("Hero") != 690 {
+		t.Fatalf("Incorrect pot %v", hand.TotalPot)
+	}
+
+	// the amounts are rounded to cents and the raises get their totals
+	if hand.Invested("Hero") != 360 || hand.Invested("Villain") != 360 {
+		t.Fatalf("Incorrect investments %v, %v", hand.Invested("Hero"), hand.Invested("Villain"))
+	}
+	raise := hand.Actions[2]
+	if raise.Type != Raise || raise.To != 60 {
+		t.Fatalf("Incorrect raise %+v", raise)
+	}
+	uncalled := hand.Actions[len(hand.Actions)-1]
+	if uncalled.Type != UncalledBet || uncalled.Player != "Hero" || uncalled.Amount != 500 || uncalled.Street != Turn {
+		t.Fatalf("Incorrect uncalled bet %+v", uncalled)
+	}
+
+	if _, err := hand.VerifyShowdown(); !errors.Is(err, ErrNoShowdown) {
+		t.Fatalf("Expecting ErrNoShowdown, got %v", err)
+	}
+}
+
+func TestOHHErrors(t *testing.T) {
+	tests := []struct {
+		text string
+		err  error
+	}{
+		{`{"hand": {}}`, ErrBadOHH},
+		{`{"ohh": {"game_type": "Razz", "bet_limit": {"bet_type": "FL"}}}`, ErrUnsupported},
+		{`{"ohh": {"game_type": "Holdem", "bet_limit": {"bet_type": "NL"}, "rounds": [{"street": "Flop",
+			"cards": ["As", "Ks"]}]}}`, ErrBadOHH},
+		{`{"ohh": {"game_type": "Holdem", "bet_limit": {"bet_type": "NL"}, "rounds": [{"street": "Preflop",
+			"actions": [{"player_id": 4, "action": "Fold"}]}]}}`, ErrUnknownPlayer},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseOHH(strings.NewReader(test.text)); !errors.Is(err, test.err) {
+			t.Fatalf("Incorrect error %v for %s", err, test.text)
+		}
+	}
+
+	if _, err := ParseOHH(strings.NewReader(`{"ohh": {"players": [{"name": "A", "cards": ["As", "Zz"]}]`)); err == nil {
+		t.Fatalf("Expecting an error for bad JSON")
+	}
+
+	// reading carries on after a hand that can't be read
+	reader := NewOHHReader(strings.NewReader(`{"ohh": {"players": [{"name": "A", "cards": ["As", "Zz"]}]}}
+		{"ohh": {"game_type": "Razz", "bet_limit": {"bet_type": "FL"}}}
+		{"ohh": {"game_type": "Holdem", "bet_limit": {"bet_type": "NL"}, "game_number": "3"}}`))
+	for want := 1; want <= 2; want++ {
+		var ohhError *OHHError
+		if _, err := reader.Next(); !errors.As(err, &ohhError) || ohhError.Hand != want {
+			t.Fatalf("Expecting an OHHError for hand %d, got %v", want, err)
+		}
+	}
+	if hand, err := reader.Next(); err != nil || hand.ID != "3" {
+		t.Fatalf("Expecting the third hand, got %v", err)
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	if _, ok := NewReader("hands.ohh", strings.NewReader("")).(*OHHReader); !ok {
+		t.Fatalf("Expecting an OHH reader for .ohh files")
+	}
+	if _, ok := NewReader("hands.txt", strings.NewReader("")).(*PokerStarsReader); !ok {
+		t.Fatalf("Expecting a PokerStars reader for .txt files")
+	}
+
+	_, ohhErr := NewReader("hands.json", strings.NewReader(`{"hand": {}}`)).Next()
+	_, textErr := NewReader("hands.txt", strings.NewReader("Not a hand\n")).Next()
+	if !IsHandError(ohhErr) || !IsHandError(textErr) || IsHandError(io.EOF) || IsHandError(nil) {
+		t.Fatalf("Incorrect hand errors %v, %v", ohhErr, textErr)
+	}
+}
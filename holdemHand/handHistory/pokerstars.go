@@ -0,0 +1,517 @@
+package handHistory
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// the zone database is built in so the ET times can be read on any host
+	_ "time/tzdata"
+
+	"holdemHand"
+)
+
+// A problem with a line of a hand history
+type ParseError struct {
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v: %q", e.Line, e.Err, e.Text)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	ErrNotAHand    = errors.New("Not the start of a hand")
+	ErrUnsupported = errors.New("Unsupported hand")
+	ErrBadLine     = errors.New("Unable to read line")
+)
+
+// Reads PokerStars text hand histories one hand at a time, so files of any size
+// can be read. A hand that can't be read is skipped after returning its error,
+// so reading can carry on with the next hand.
+type PokerStarsReader struct {
+	scanner *bufio.Scanner
+	line    int
+	// the header of the next hand, already read
+	next     string
+	nextLine int
+}
+
+var (
+	pokerStarsHeader = regexp.MustCompile(`^PokerStars (?:Zoom )?(?:Hand|Game) #(\d+):\s+(.*)$`)
+	pokerStarsBlinds = regexp.MustCompile(`\(([^()/ ]+)/([^()/ ]+)(?: ([A-Z]{3}))?\)`)
+	pokerStarsGame   = regexp.MustCompile(`(Hold'em|Omaha(?: Hi/Lo)?) (No Limit|Pot Limit|Limit)`)
+	pokerStarsTime   = regexp.MustCompile(`(\d{4}/\d{2}/\d{2} \d{1,2}:\d{2}:\d{2})(?: ([A-Z]+))?`)
+	pokerStarsTable  = regexp.MustCompile(`^Table '([^']*)' (\d+)-max(?: \([^)]*\))? Seat #(\d+) is the button`)
+	pokerStarsSeat   = regexp.MustCompile(`^Seat (\d+): (.+) \(([^ ]+) in chips(?:, [^)]*)?\)( is sitting out| out of hand.*)?$`)
+	pokerStarsStreet = regexp.MustCompile(`^\*\*\* (HOLE CARDS|FLOP|TURN|RIVER|SHOW ?DOWN|SUMMARY|FIRST FLOP|FIRST TURN|FIRST RIVER) \*\*\*(.*)$`)
+	pokerStarsCards  = regexp.MustCompile(`\[([^\]]*)\]`)
+	pokerStarsTotal  = regexp.MustCompile(`^Total pot ([^ ]+)(?:.*\| Rake ([^ ]+))?`)
+	pokerStarsPot    = regexp.MustCompile(`^(.+) collected ([^ ]+) from (pot|main pot|side pot(?:-(\d+))?)$`)
+	pokerStarsReturn = regexp.MustCompile(`^Uncalled bet \(([^)]+)\) returned to (.+)$`)
+	pokerStarsDealt  = regexp.MustCompile(`^Dealt to (.+?) \[([^\]]*)\]$`)
+	pokerStarsShown  = regexp.MustCompile(`^Seat \d+: (.+?) (?:\([^)]*\) )?(?:showed|mucked) \[([^\]]*)\]`)
+
+	// the time zone of the hand times
+	pokerStarsEastern = func() *time.Location {
+		location, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			panic(err)
+		}
+		return location
+	}()
+)
+
+func NewPokerStarsReader(r io.Reader) *PokerStarsReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return &PokerStarsReader{scanner: scanner}
+}
+
+// Reads every hand, stopping at the first error.
+func ParsePokerStars(r io.Reader) ([]*Hand, error) {
+	reader := NewPokerStarsReader(r)
+	hands := []*Hand{}
+	for {
+		hand, err := reader.Next()
+		if err == io.EOF {
+			return hands, nil
+		}
+		if err != nil {
+			return hands, err
+		}
+		hands = append(hands, hand)
+	}
+}
+
+// Returns the next hand, or io.EOF when there are no more. Errors reading a hand
+// are *ParseError with the line number.
+func (r *PokerStarsReader) Next() (*Hand, error) {
+	header, headerLine := r.next, r.nextLine
+	r.next = ""
+
+	// find the start of the hand
+	for header == "" {
+		text, ok := r.readLine()
+		if !ok {
+			if err := r.scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		if text == "" {
+			continue
+		}
+		if !pokerStarsHeader.MatchString(text) {
+			return nil, &ParseError{r.line, text, ErrNotAHand}
+		}
+		header, headerLine = text, r.line
+	}
+
+	// read the lines up to the next hand
+	lines := []string{header}
+	for {
+		text, ok := r.readLine()
+		if !ok {
+			if err := r.scanner.Err(); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if pokerStarsHeader.MatchString(text) {
+			r.next, r.nextLine = text, r.line
+			break
+		}
+		lines = append(lines, text)
+	}
+
+	parser := pokerStarsParser{
+		hand:          &Hand{Site: "PokerStars", Line: headerLine},
+		contributions: map[string]Amount{},
+	}
+	for i, text := range lines {
+		if err := parser.parseLine(text); err != nil {
+			return nil, &ParseError{headerLine + i, text, err}
+		}
+	}
+	if err := parser.finish(); err != nil {
+		return nil, &ParseError{headerLine, header, err}
+	}
+	return parser.hand, nil
+}
+
+func (r *PokerStarsReader) readLine() (string, bool) {
+	if !r.scanner.Scan() {
+		return "", false
+	}
+	r.line++
+	text := strings.TrimRight(r.scanner.Text(), " \r")
+	if r.line == 1 {
+		text = strings.TrimPrefix(text, "\uFEFF")
+	}
+	return text, true
+}
+
+// the state of a hand being read
+type pokerStarsParser struct {
+	hand    *Hand
+	street  Street
+	summary bool
+	// each player's bet on the current street
+	contributions map[string]Amount
+	line          int
+}
+
+func (p *pokerStarsParser) parseLine(text string) error {
+	p.line++
+	if text == "" {
+		return nil
+	}
+
+	if p.line == 1 {
+		return p.parseHeader(text)
+	}
+
+	if match := pokerStarsStreet.FindStringSubmatch(text); match != nil {
+		return p.parseStreet(match[1], match[2])
+	}
+
+	if p.summary {
+		return p.parseSummary(text)
+	}
+
+	if match := pokerStarsTable.FindStringSubmatch(text); match != nil {
+		p.hand.Table = match[1]
+		p.hand.MaxSeats, _ = strconv.Atoi(match[2])
+		p.hand.Button, _ = strconv.Atoi(match[3])
+		return nil
+	}
+
+	if match := pokerStarsSeat.FindStringSubmatch(text); match != nil && p.street == Preflop && len(p.hand.Actions) == 0 {
+		seat, _ := strconv.Atoi(match[1])
+		stack, err := ParseAmount(match[3])
+		if err != nil {
+			return err
+		}
+		p.hand.Players = append(p.hand.Players, Player{
+			Seat:       seat,
+			Name:       match[2],
+			Stack:      stack,
+			SittingOut: match[4] != "",
+		})
+		return nil
+	}
+
+	if match := pokerStarsDealt.FindStringSubmatch(text); match != nil {
+		cards, err := parseCards(match[2])
+		if err != nil {
+			return err
+		}
+		player := p.hand.Player(match[1])
+		if player == nil {
+			return ErrUnknownPlayer
+		}
+		player.HoleCards = cards
+		p.hand.Hero = player.Name
+		return nil
+	}
+
+	if match := pokerStarsReturn.FindStringSubmatch(text); match != nil {
+		amount, err := ParseAmount(match[1])
+		if err != nil {
+			return err
+		}
+		if p.hand.Player(match[2]) == nil {
+			return ErrUnknownPlayer
+		}
+		p.contributions[match[2]] -= amount
+		p.addAction(Action{Player: match[2], Type: UncalledBet, Amount: amount})
+		return nil
+	}
+
+	if match := pokerStarsPot.FindStringSubmatch(text); match != nil {
+		amount, err := ParseAmount(match[2])
+		if err != nil {
+			return err
+		}
+		if p.hand.Player(match[1]) == nil {
+			return ErrUnknownPlayer
+		}
+		pot := 0
+		if match[3] == "side pot" {
+			pot = 1
+		} else if match[4] != "" {
+			pot, _ = strconv.Atoi(match[4])
+		}
+		p.hand.Winnings = append(p.hand.Winnings, Winning{Player: match[1], Amount: amount, Pot: pot})
+		return nil
+	}
+
+	if player, action, ok := p.splitAction(text); ok {
+		return p.parseAction(player, action)
+	}
+
+	// chat, players joining and leaving and so on
+	return nil
+}
+
+func (p *pokerStarsParser) parseHeader(text string) error {
+	match := pokerStarsHeader.FindStringSubmatch(text)
+	p.hand.ID = match[1]
+	rest := match[2]
+
+	if strings.HasPrefix(rest, "Tournament #") {
+		id, _, _ := strings.Cut(strings.TrimPrefix(rest, "Tournament #"), ",")
+		p.hand.Tournament = id
+	}
+
+	game := pokerStarsGame.FindString(rest)
+	if game == "" {
+		return fmt.Errorf("%w: unknown game", ErrUnsupported)
+	}
+	p.hand.Game = game
+
+	blinds := pokerStarsBlinds.FindStringSubmatch(rest)
+	if blinds == nil {
+		return fmt.Errorf("%w: no blinds", ErrBadLine)
+	}
+	var err error
+	if p.hand.SmallBlind, err = ParseAmount(blinds[1]); err != nil {
+		return err
+	}
+	if p.hand.BigBlind, err = ParseAmount(blinds[2]); err != nil {
+		return err
+	}
+	p.hand.Currency = blinds[3]
+	if p.hand.Currency == "" && strings.HasPrefix(blinds[1], "$") {
+		p.hand.Currency = "USD"
+	}
+
+	// the time in ET, which follows the local time in brackets for other time zones
+	if dates := pokerStarsTime.FindAllStringSubmatch(rest, -1); dates != nil {
+		date := dates[0][1]
+		for _, match := range dates {
+			if match[2] == "ET" {
+				date = match[1]
+				break
+			}
+		}
+		started, err := time.ParseInLocation("2006/01/02 15:04:05", date, pokerStarsEastern)
+		if err != nil {
+			return err
+		}
+		p.hand.Time = started.UTC()
+	}
+	return nil
+}
+
+func (p *pokerStarsParser) parseStreet(name string, cards string) error {
+	switch name {
+	case "HOLE CARDS":
+		// the blinds posted before the cards are dealt count towards the preflop bets
+		p.street = Preflop
+		return nil
+	case "SHOW DOWN", "SHOWDOWN":
+		p.street = Showdown
+		return nil
+	case "SUMMARY":
+		p.summary = true
+		return nil
+	case "FIRST FLOP", "FIRST TURN", "FIRST RIVER":
+		return fmt.Errorf("%w: the board was run twice", ErrUnsupported)
+	}
+
+	// the new cards are in the last brackets
+	brackets := pokerStarsCards.FindAllStringSubmatch(cards, -1)
+	if len(brackets) == 0 {
+		return fmt.Errorf("%w: no board cards", ErrBadLine)
+	}
+	dealt, err := parseCards(brackets[len(brackets)-1][1])
+	if err != nil {
+		return err
+	}
+
+	want := map[string]int{"FLOP": 3, "TURN": 1, "RIVER": 1}[name]
+	before := map[string]int{"FLOP": 0, "TURN": 3, "RIVER": 4}[name]
+	if len(dealt) != want || len(p.hand.Board) != before {
+		return fmt.Errorf("%w: the board is out of order", ErrBadLine)
+	}
+	p.hand.Board = append(p.hand.Board, dealt...)
+	p.contributions = map[string]Amount{}
+	p.street = map[string]Street{"FLOP": Flop, "TURN": Turn, "RIVER": River}[name]
+	return nil
+}
+
+func (p *pokerStarsParser) parseSummary(text string) error {
+	if match := pokerStarsTotal.FindStringSubmatch(text); match != nil {
+		var err error
+		if p.hand.TotalPot, err = ParseAmount(match[1]); err != nil {
+			return err
+		}
+		if match[2] != "" {
+			if p.hand.Rake, err = ParseAmount(match[2]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(text, "Board [") {
+		board, err := parseCards(strings.TrimSuffix(strings.TrimPrefix(text, "Board ["), "]"))
+		if err != nil {
+			return err
+		}
+		if board.String() != p.hand.Board.String() {
+			return fmt.Errorf("%w: the board doesn't match the streets", ErrBadLine)
+		}
+		return nil
+	}
+
+	// cards shown or mucked at showdown
+	if match := pokerStarsShown.FindStringSubmatch(text); match != nil {
+		player := p.hand.Player(match[1])
+		if player == nil {
+			return ErrUnknownPlayer
+		}
+		cards, err := parseCards(match[2])
+		if err != nil {
+			return err
+		}
+		player.HoleCards = cards
+	}
+	return nil
+}
+
+// splits "name: action" using the player names, as names can have colons in them
+func (p *pokerStarsParser) splitAction(text string) (string, string, bool) {
+	best := ""
+	for _, player := range p.hand.Players {
+		if len(player.Name) > len(best) && strings.HasPrefix(text, player.Name+": ") {
+			best = player.Name
+		}
+	}
+	if best == "" {
+		return "", "", false
+	}
+	return best, text[len(best)+2:], true
+}
+
+func (p *pokerStarsParser) parseAction(player string, text string) error {
+	action := Action{Player: player}
+	text, action.AllIn = strings.CutSuffix(text, " and is all-in")
+
+	verb, amount := text, ""
+	for _, prefix := range []string{"posts small & big blinds ", "posts small blind ", "posts big blind ",
+		"posts the ante ", "posts straddle ", "calls ", "bets ", "raises "} {
+		if strings.HasPrefix(text, prefix) {
+			verb, amount = strings.TrimSpace(prefix), text[len(prefix):]
+			break
+		}
+	}
+
+	var err error
+	switch verb {
+	case "posts small blind", "posts big blind", "posts small & big blinds", "posts straddle", "calls", "bets":
+		if action.Amount, err = ParseAmount(amount); err != nil {
+			return err
+		}
+		action.Type = map[string]ActionType{"posts small blind": PostSmallBlind, "posts big blind": PostBigBlind,
+			"posts small & big blinds": PostBlind, "posts straddle": PostBlind, "calls": Call, "bets": Bet}[verb]
+		if verb == "posts small & big blinds" {
+			// the small blind is dead, only the big blind counts towards a raise
+			p.contributions[player] += p.hand.BigBlind
+		} else {
+			p.contributions[player] += action.Amount
+		}
+		if action.Type == Bet {
+			action.To = p.contributions[player]
+		}
+
+	case "posts the ante":
+		if action.Amount, err = ParseAmount(amount); err != nil {
+			return err
+		}
+		action.Type = PostAnte
+		p.hand.Ante = max(p.hand.Ante, action.Amount)
+
+	case "raises":
+		// raises 10 to 30
+		_, to, found := strings.Cut(amount, " to ")
+		if !found {
+			return fmt.Errorf("%w: raise without a total", ErrBadLine)
+		}
+		if action.To, err = ParseAmount(to); err != nil {
+			return err
+		}
+		action.Type = Raise
+		action.Amount = action.To - p.contributions[player]
+		if action.Amount <= 0 {
+			return fmt.Errorf("%w: raise to less than the player's bet", ErrBadLine)
+		}
+		p.contributions[player] = action.To
+
+	case "folds", "checks", "mucks hand":
+		action.Type = map[string]ActionType{"folds": Fold, "checks": Check, "mucks hand": Muck}[verb]
+
+	default:
+		if !strings.HasPrefix(verb, "shows [") {
+			// sits out, doesn't show hand and so on
+			return nil
+		}
+		cards, err := parseCards(pokerStarsCards.FindStringSubmatch(verb)[1])
+		if err != nil {
+			return err
+		}
+		action.Type = Show
+		action.Cards = cards
+		p.hand.Player(player).HoleCards = cards
+	}
+
+	p.addAction(action)
+	return nil
+}
+
+func (p *pokerStarsParser) addAction(action Action) {
+	action.Street = p.street
+	action.Line = p.hand.Line + p.line - 1
+	p.hand.Actions = append(p.hand.Actions, action)
+}
+
+func (p *pokerStarsParser) finish() error {
+	if len(p.hand.Players) == 0 {
+		return fmt.Errorf("%w: no players", ErrBadLine)
+	}
+	if !p.summary {
+		return fmt.Errorf("%w: the hand has no summary", ErrBadLine)
+	}
+	return nil
+}
+
+// reads cards such as "As Kh", keeping their order
+func parseCards(text string) (holdemHand.CardList, error) {
+	cards := holdemHand.CardList{}
+	seen := uint64(0)
+	for _, field := range strings.Fields(text) {
+		mask, err := holdemHand.ParseHand(field)
+		if err != nil {
+			return nil, err
+		}
+		if bits.OnesCount64(mask) != 1 || mask&seen != 0 {
+			return nil, fmt.Errorf("%w: %q", holdemHand.ErrInvalidCard, field)
+		}
+		seen |= mask
+		cards = append(cards, bits.TrailingZeros64(mask))
+	}
+	return cards, nil
+}
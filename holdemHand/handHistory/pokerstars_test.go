@@ -0,0 +1,225 @@
+package handHistory
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"holdemHand"
+)
+
+func readSampleHands(t *testing.T) []*Hand {
+	file, err := os.Open("testdata/pokerstars.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	// the third hand has a bad card
+	reader := NewPokerStarsReader(file)
+	hands := []*Hand{}
+	for {
+		hand, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseError *ParseError
+			if !errors.As(err, &parseError) || parseError.Line != 82 || !errors.Is(err, holdemHand.ErrBadHand) {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			continue
+		}
+		hands = append(hands, hand)
+	}
+	if len(hands) != 4 {
+		t.Fatalf("Expecting 4 hands, got %d", len(hands))
+	}
+	return hands
+}
+
+func TestPokerStarsCashHand(t *testing.T) {
+	hand := readSampleHands(t)[0]
+
+	if hand.ID != "240000000001" || hand.Tournament != "" || hand.Game != "Hold'em No Limit" || hand.Currency != "USD" {
+		t.Fatalf("Incorrect header %+v", hand)
+	}
+	if hand.SmallBlind != 5 || hand.BigBlind != 10 || hand.Time.Format("2006-01-02 15:04") != "2023-01-16 01:30" {
+		t.Fatalf("Incorrect blinds or time %+v", hand)
+	}
+	if hand.Table != "Alcyone II" || hand.MaxSeats != 6 || hand.Button != 1 || len(hand.Players) != 4 || hand.Line != 1 {
+		t.Fatalf("Incorrect table %+v", hand)
+	}
+	if player := hand.Player("Dan:2"); player == nil || player.Seat != 4 || player.Stack != 1000 {
+		t.Fatalf("Incorrect player %+v", player)
+	}
+	if hand.Hero != "Alice" || hand.Player("Alice").HoleCards.String() != "Ah Kd" {
+		t.Fatalf("Incorrect hero %q", hand.Hero)
+	}
+	if hand.Board.String() != "Ks 7h 2c 9d 3s" || hand.BoardAt(Turn).String() != "Ks 7h 2c 9d" {
+		t.Fatalf("Incorrect board %v", hand.Board)
+	}
+	if hand.TotalPot != 345 || hand.Rake != 5 || hand.Won("Carol") != 340 {
+		t.Fatalf("Incorrect pot %v, rake %v", hand.TotalPot, hand.Rake)
+	}
+	if hand.Invested("Alice") != 170 || hand.Invested("Carol") != 170 || hand.Invested("Bob") != 5 {
+		t.Fatalf("Incorrect investments")
+	}
+
+	raise := hand.Actions[3]
+	if raise.Player != "Alice" || raise.Type != Raise || raise.Amount != 30 || raise.To != 30 || raise.Line != 12 {
+		t.Fatalf("Incorrect raise %+v", raise)
+	}
+	if got := strings.Join(hand.ActivePlayers(), ","); got != "Alice,Carol" {
+		t.Fatalf("Incorrect active players %s", got)
+	}
+
+	shown, err := hand.VerifyShowdown()
+	if err != nil || len(shown) != 1 || shown[0].Player != "Carol" {
+		t.Fatalf("Incorrect showdown %v, %v", shown, err)
+	}
+}
+
+func TestPokerStarsSidePots(t *testing.T) {
+	hand := readSampleHands(t)[1]
+
+	if hand.Tournament != "3500000001" || hand.Ante != 1000 || hand.BigBlind != 10000 {
+		t.Fatalf("Incorrect header %+v", hand)
+	}
+	if !hand.Player("Erin").SittingOut || len(hand.ActivePlayers()) != 3 {
+		t.Fatalf("Incorrect players %+v", hand.Players)
+	}
+
+	allIn := 0
+	for _, action := range hand.Actions {
+		if action.AllIn {
+			allIn++
+		}
+	}
+	if allIn != 3 || hand.Invested("Carol") != 300000 || hand.Invested("Alice") != 100000 {
+		t.Fatalf("Incorrect all in actions")
+	}
+	if len(hand.Winnings) != 2 || hand.Winnings[0].Pot != 1 || hand.Winnings[1].Pot != 0 {
+		t.Fatalf("Incorrect winnings %+v", hand.Winnings)
+	}
+
+	shown, err := hand.VerifyShowdown()
+	if err != nil || len(shown) != 3 || shown[0].Player != "Alice" || shown[2].Player != "Carol" {
+		t.Fatalf("Incorrect showdown %v, %v", shown, err)
+	}
+
+	hand.Winnings[1].Player = "Bob"
+	if _, err := hand.VerifyShowdown(); !errors.Is(err, ErrWrongAward) {
+		t.Fatalf("Expecting ErrWrongAward, got %v", err)
+	}
+}
+
+func TestPokerStarsNoShowdown(t *testing.T) {
+	hand := readSampleHands(t)[2]
+
+	if hand.ID != "240000000004" || hand.Line != 93 || hand.Won("Bob") != 10 || hand.Invested("Bob") != 5 {
+		t.Fatalf("Incorrect hand %+v", hand)
+	}
+	if _, err := hand.VerifyShowdown(); !errors.Is(err, ErrNoShowdown) {
+		t.Fatalf("Expecting ErrNoShowdown, got %v", err)
+	}
+}
+
+func TestPokerStarsDeadBlind(t *testing.T) {
+	hand := readSampleHands(t)[3]
+
+	// the dead small blind doesn't count towards the raise
+	raise := hand.Actions[3]
+	if raise.Player != "Ivy" || raise.Type != Raise || raise.Amount != 20 || raise.To != 30 {
+		t.Fatalf("Incorrect raise %+v", raise)
+	}
+	if hand.Invested("Ivy") != 35 || hand.Invested("Hank") != 30 || hand.Won("Ivy") != 67 {
+		t.Fatalf("Incorrect investments")
+	}
+}
+
+func TestPokerStarsTime(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2023/01/15 20:30:00 ET", "2023-01-16T01:30:00Z"},
+		{"2023/07/15 20:30:00 ET", "2023-07-16T00:30:00Z"},
+		{"2023/01/16 2:30:00 CET [2023/01/15 20:30:00 ET]", "2023-01-16T01:30:00Z"},
+	}
+
+	for _, test := range tests {
+		hands, err := ParsePokerStars(strings.NewReader("PokerStars Hand #1:  Hold'em No Limit ($0.05/$0.10 USD) - " +
+			test.date + "\nSeat 1: Alice ($1 in chips)\n*** SUMMARY ***\n"))
+		if err != nil {
+			t.Fatalf("Unexpected error %v for %q", err, test.date)
+		}
+		if got := hands[0].Time.Format(time.RFC3339); got != test.want {
+			t.Fatalf("Incorrect time for %q. Want %s, Got %s", test.date, test.want, got)
+		}
+	}
+}
+
+func TestPokerStarsErrors(t *testing.T) {
+	tests := []struct {
+		text string
+		line int
+		err  error
+	}{
+		{"Not a hand\n", 1, ErrNotAHand},
+		{"PokerStars Hand #1:  Razz Limit ($0.05/$0.10 USD)\n", 1, ErrUnsupported},
+		{"PokerStars Hand #1:  Hold'em No Limit ($0.05/$0.10 USD)\nSeat 1: Alice ($1 in chips)\n" +
+			"Bob: posts small blind $0.05\n*** SUMMARY ***\n", 1, nil},
+		{"PokerStars Hand #1:  Hold'em No Limit ($0.05/$0.10 USD)\nSeat 1: Alice ($1 in chips)\n" +
+			"Uncalled bet ($0.05) returned to Bob\n", 3, ErrUnknownPlayer},
+		{"PokerStars Hand #1:  Hold'em No Limit ($0.05/$0.10 USD)\nSeat 1: Alice ($1 in chips)\n" +
+			"*** FLOP *** [Ks 7h]\n", 3, ErrBadLine},
+		{"PokerStars Hand #1:  Hold'em No Limit ($0.05/$0.10 USD)\nSeat 1: Alice ($1 in chips)\n", 1, ErrBadLine},
+	}
+
+	for _, test := range tests {
+		_, err := ParsePokerStars(strings.NewReader(test.text))
+		if test.err == nil {
+			if err != nil {
+				t.Fatalf("Unexpected error %v for %q", err, test.text)
+			}
+			continue
+		}
+
+		var parseError *ParseError
+		if !errors.As(err, &parseError) || parseError.Line != test.line || !errors.Is(err, test.err) {
+			t.Fatalf("Incorrect error %v for %q", err, test.text)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		text string
+		want Amount
+		ok   bool
+	}{
+		{"$1,234.50", 123450, true},
+		{"€0.05", 5, true},
+		{"1500", 150000, true},
+		{"0.5", 50, true},
+		{"1.234", 0, false},
+		{"", 0, false},
+		{"1.", 0, false},
+		{"-1", 0, false},
+	}
+
+	for _, test := range tests {
+		got, err := ParseAmount(test.text)
+		if (err == nil) != test.ok || got != test.want {
+			t.Fatalf("Incorrect amount for %q. Want %d, Got %d, %v", test.text, test.want, got, err)
+		}
+	}
+
+	if Amount(123450).String() != "1234.50" || Amount(150000).String() != "1500" || Amount(-5).String() != "-0.05" {
+		t.Fatalf("Incorrect amount text")
+	}
+}
@@ -0,0 +1,29 @@
+package handHistory
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+)
+
+// Reads hands one at a time, returning io.EOF when there are no more
+type Reader interface {
+	Next() (*Hand, error)
+}
+
+// Returns a reader for the file called name: files ending in .ohh or .json are
+// read as Open Hand History, anything else as PokerStars text.
+func NewReader(name string, r io.Reader) Reader {
+	if ext := filepath.Ext(name); ext == ".ohh" || ext == ".json" {
+		return NewOHHReader(r)
+	}
+	return NewPokerStarsReader(r)
+}
+
+// Whether err is a problem with a single hand, after which the reader carries on
+// with the next one
+func IsHandError(err error) bool {
+	var parseError *ParseError
+	var ohhError *OHHError
+	return errors.As(err, &parseError) || errors.As(err, &ohhError)
+}
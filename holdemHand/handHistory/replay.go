@@ -0,0 +1,326 @@
+package handHistory
+
+import (
+	"slices"
+	"strings"
+)
+
+// The table as a hand is replayed
+type ReplayState struct {
+	Street Street
+	// the chips each player has left in front of them
+	Stacks map[string]Amount
+	// each player's live bet on the current street
+	Bets map[string]Amount
+	// the chips each player has put in the pot, less uncalled bets
+	Invested map[string]Amount
+	Folded   map[string]bool
+	AllIn    map[string]bool
+	// the biggest bet on the street and the smallest raise allowed on top of it
+	CurrentBet Amount
+	MinRaise   Amount
+	// the players who still have to act on the street
+	ToAct map[string]bool
+	// the chips in the pot, including the bets on the current street
+	Pot Amount
+
+	hand *Hand
+	// the players dealt in, in seat order
+	order []string
+	// the last player to act, the next player to act is after them
+	last string
+}
+
+// Replays the hand action by action, checking each action is legal: players act
+// in turn, checks, calls and bets match the betting, bets and raises are big enough
+// (and in pot limit not too big) and nobody puts in more than their stack.
+// callback, which can be nil, is called with the index of each action and the
+// state after it; returning false stops the replay. Returns the problems found.
+func (hand *Hand) Replay(callback func(index int, state *ReplayState) bool) []Finding {
+	state := newReplayState(hand)
+	findings := []Finding{}
+
+	for index, action := range hand.Actions {
+		if _, ok := state.Stacks[action.Player]; !ok {
+			findings = append(findings, newFinding(Error, UnknownPlayerFinding, index, action,
+				"%s isn't seated at the table", action.Player))
+			continue
+		}
+
+		if action.Street != state.Street && action.Street > Preflop {
+			findings = append(findings, state.endStreet(index-1)...)
+			state.startStreet(action.Street)
+		}
+		findings = append(findings, state.apply(index, action)...)
+
+		if callback != nil && !callback(index, state) {
+			break
+		}
+	}
+	return append(findings, state.endStreet(len(hand.Actions)-1)...)
+}
+
+func newReplayState(hand *Hand) *ReplayState {
+	state := &ReplayState{
+		Stacks:   map[string]Amount{},
+		Bets:     map[string]Amount{},
+		Invested: map[string]Amount{},
+		Folded:   map[string]bool{},
+		AllIn:    map[string]bool{},
+		ToAct:    map[string]bool{},
+		MinRaise: hand.BigBlind,
+		hand:     hand,
+	}
+	for _, player := range hand.Players {
+		state.Stacks[player.Name] = player.Stack
+		if hand.dealtIn(player.Name) {
+			state.order = append(state.order, player.Name)
+			state.ToAct[player.Name] = true
+		}
+	}
+
+	// the first to act preflop is after the last blind
+	state.last = state.buttonPlayer()
+	for _, action := range hand.Actions {
+		if action.Type == PostBigBlind || (action.Type == PostBlind && action.Amount >= 2*hand.BigBlind) {
+			state.last = action.Player
+		}
+	}
+	return state
+}
+
+// the player on the button, or the nearest player before it
+func (state *ReplayState) buttonPlayer() string {
+	if len(state.order) == 0 {
+		return ""
+	}
+	button := state.order[len(state.order)-1]
+	for _, name := range state.order {
+		if state.hand.Player(name).Seat <= state.hand.Button {
+			button = name
+		}
+	}
+	return button
+}
+
+// the next player who has to act, after the last player to act
+func (state *ReplayState) nextToAct() string {
+	start := slices.Index(state.order, state.last)
+	for i := 1; i <= len(state.order); i++ {
+		name := state.order[(start+i)%len(state.order)]
+		if state.ToAct[name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// the players who haven't folded
+func (state *ReplayState) inHand() []string {
+	players := []string{}
+	for _, name := range state.order {
+		if !state.Folded[name] {
+			players = append(players, name)
+		}
+	}
+	return players
+}
+
+// the players who haven't folded or gone all in
+func (state *ReplayState) canAct() []string {
+	players := []string{}
+	for _, name := range state.inHand() {
+		if !state.AllIn[name] {
+			players = append(players, name)
+		}
+	}
+	return players
+}
+
+func (state *ReplayState) startStreet(street Street) {
+	state.Street = street
+	clear(state.Bets)
+	clear(state.ToAct)
+	state.CurrentBet = 0
+	state.MinRaise = state.hand.BigBlind
+	state.last = state.buttonPlayer()
+
+	// nobody acts when at most one player has chips behind
+	if players := state.canAct(); street < Showdown && len(players) > 1 {
+		for _, name := range players {
+			state.ToAct[name] = true
+		}
+	}
+}
+
+// checks the betting on the street finished, and that a bet nobody called was given back
+func (state *ReplayState) endStreet(index int) []Finding {
+	findings := []Finding{}
+	action := Action{Street: state.Street}
+	if index >= 0 {
+		action = state.hand.Actions[index]
+		action.Player = ""
+	}
+
+	if len(state.inHand()) > 1 && len(state.ToAct) > 0 {
+		waiting := []string{}
+		for _, name := range state.order {
+			if state.ToAct[name] {
+				waiting = append(waiting, name)
+			}
+		}
+		findings = append(findings, newFinding(Error, IncompleteRound, index, action,
+			"the betting ended before %s acted", strings.Join(waiting, ", ")))
+	}
+
+	top, second, player := state.topBets()
+	if top > second {
+		action.Player = player
+		findings = append(findings, newFinding(Error, WrongUncalledBet, index, action,
+			"%s of %s's bet wasn't called or given back", top-second, player))
+	}
+	return findings
+}
+
+// the two biggest bets on the street and the player who made the biggest
+func (state *ReplayState) topBets() (Amount, Amount, string) {
+	top, second, player := Amount(0), Amount(0), ""
+	for _, name := range state.order {
+		if bet := state.Bets[name]; bet > top {
+			top, second, player = bet, top, name
+		} else if bet > second {
+			second = bet
+		}
+	}
+	return top, second, player
+}
+
+// plays one action, returning any problems with it
+func (state *ReplayState) apply(index int, action Action) []Finding {
+	findings := []Finding{}
+	report := func(severity Severity, kind FindingKind, format string, args ...any) {
+		findings = append(findings, newFinding(severity, kind, index, action, format, args...))
+	}
+
+	name := action.Player
+	stack := state.Stacks[name]
+	bet := state.Bets[name]
+	voluntary := action.Type >= Fold && action.Type <= Raise
+
+	if voluntary {
+		switch expected := state.nextToAct(); {
+		case state.Folded[name]:
+			report(Error, IllegalAction, "%s acts after folding", name)
+		case state.AllIn[name]:
+			report(Error, IllegalAction, "%s acts after going all in", name)
+		case len(state.ToAct) == 0:
+			report(Error, OutOfTurn, "%s acts after the betting was closed", name)
+		case !state.ToAct[name]:
+			report(Error, OutOfTurn, "%s acts out of turn", name)
+		case expected != name:
+			report(Error, OutOfTurn, "%s acts before %s", name, expected)
+		}
+		delete(state.ToAct, name)
+		state.last = name
+	}
+
+	if action.Amount > stack && action.Type != UncalledBet {
+		report(Error, OverStack, "%s puts in %s with %s behind", name, action.Amount, stack)
+	}
+	// fixed limit bet sizes aren't checked
+	minimumBets := strings.HasSuffix(state.hand.Game, "No Limit") || strings.HasSuffix(state.hand.Game, "Pot Limit")
+	potLimit := strings.HasSuffix(state.hand.Game, "Pot Limit")
+
+	switch action.Type {
+	case Fold:
+		state.Folded[name] = true
+
+	case Check:
+		if bet < state.CurrentBet {
+			report(Error, IllegalAction, "%s checks facing a bet of %s", name, state.CurrentBet)
+		}
+
+	case Call:
+		if expected := min(state.CurrentBet-bet, stack); action.Amount != expected {
+			report(Error, IllegalAction, "%s calls %s, the call is %s", name, action.Amount, expected)
+		}
+
+	case Bet:
+		if state.CurrentBet > 0 {
+			report(Error, IllegalAction, "%s bets facing a bet of %s", name, state.CurrentBet)
+		} else if minimumBets && action.Amount < state.hand.BigBlind && action.Amount < stack {
+			report(Error, BetTooSmall, "%s bets %s, the smallest bet is %s", name, action.Amount, state.hand.BigBlind)
+		} else if potLimit && action.Amount > state.Pot {
+			report(Error, BetTooLarge, "%s bets %s, the pot is %s", name, action.Amount, state.Pot)
+		}
+		state.MinRaise = max(state.MinRaise, action.Amount)
+
+	case Raise:
+		to := bet + action.Amount
+		increment := to - state.CurrentBet
+		maxTo := state.CurrentBet + state.Pot + (state.CurrentBet - bet)
+		if increment <= 0 {
+			report(Error, IllegalAction, "%s raises to %s facing a bet of %s", name, to, state.CurrentBet)
+		} else if minimumBets && increment < state.MinRaise && action.Amount < stack {
+			report(Error, BetTooSmall, "%s raises to %s, the smallest raise is to %s",
+				name, to, state.CurrentBet+state.MinRaise)
+		} else if potLimit && to > maxTo {
+			report(Error, BetTooLarge, "%s raises to %s, the biggest raise is to %s", name, to, maxTo)
+		}
+		if increment >= state.MinRaise {
+			state.MinRaise = increment
+		}
+
+	case UncalledBet:
+		top, second, player := state.topBets()
+		if player != name || action.Amount != top-second {
+			report(Error, WrongUncalledBet, "%s is given back %s, %s's uncalled bet is %s",
+				name, action.Amount, player, top-second)
+		}
+		state.Bets[name] -= action.Amount
+		state.Invested[name] -= action.Amount
+		state.Stacks[name] += action.Amount
+		state.Pot -= action.Amount
+		return findings
+	}
+
+	if action.Amount <= 0 || action.Type > Raise {
+		return findings
+	}
+
+	amount := min(action.Amount, stack)
+	state.Stacks[name] -= amount
+	state.Invested[name] += amount
+	state.Pot += amount
+	switch {
+	case action.Type == PostAnte:
+	case action.Type == PostBlind && amount > state.hand.BigBlind && amount < 2*state.hand.BigBlind:
+		// a small blind posted dead with the big blind
+		state.Bets[name] += state.hand.BigBlind
+	default:
+		state.Bets[name] += amount
+	}
+
+	if state.Bets[name] > state.CurrentBet {
+		state.CurrentBet = state.Bets[name]
+		if action.Type == Bet || action.Type == Raise {
+			// everyone else has to act again
+			for _, other := range state.canAct() {
+				if other != name {
+					state.ToAct[other] = true
+				}
+			}
+		}
+	}
+
+	if state.Stacks[name] == 0 {
+		state.AllIn[name] = true
+		delete(state.ToAct, name)
+		if !action.AllIn && voluntary {
+			report(Warning, AllInMismatch, "%s is all in but the action doesn't say so", name)
+		}
+	} else if action.AllIn {
+		report(Warning, AllInMismatch, "%s is marked all in with %s behind", name, state.Stacks[name])
+	}
+	return findings
+}
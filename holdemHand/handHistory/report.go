@@ -0,0 +1,129 @@
+package handHistory
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// Each player's results over a set of hands, such as a session, with the results
+// of the hands that were all in before the river next to their all in adjusted
+// results. Reports can be merged to sum up several sessions.
+type SessionReport struct {
+	Hands      int
+	AllInHands int
+	// all in hands whose equity couldn't be worked out, such as when a player's
+	// cards weren't shown
+	Skipped int
+	Players map[string]*PlayerResult
+}
+
+// A player's results in a SessionReport
+type PlayerResult struct {
+	Player string
+	Hands  int
+	// what the player won less what they put in
+	Net        Amount
+	AllInHands int
+	// the part of Net from the all in hands
+	AllInNet Amount
+	// what the player would have made in the all in hands winning their equity
+	AllInExpectedNet Amount
+}
+
+func NewSessionReport() *SessionReport {
+	return &SessionReport{Players: map[string]*PlayerResult{}}
+}
+
+// The player's result with the all in hands counted at their equity
+func (result *PlayerResult) AdjustedNet() Amount {
+	return result.Net - result.AllInNet + result.AllInExpectedNet
+}
+
+// How much more the player won in the all in hands than their equity was worth
+func (result *PlayerResult) Luck() Amount {
+	return result.AllInNet - result.AllInExpectedNet
+}
+
+// Adds a hand to the report and returns its all in equity, nil when the hand
+// wasn't all in before the river. A hand whose equity can't be worked out is
+// added without it and counted as skipped, and the error is returned.
+func (report *SessionReport) Add(hand *Hand) (*AllInEquity, error) {
+	report.Hands++
+	for _, player := range hand.Players {
+		if hand.dealtIn(player.Name) {
+			result := report.player(player.Name)
+			result.Hands++
+			result.Net += hand.Won(player.Name) - hand.Invested(player.Name)
+		}
+	}
+
+	equity, err := hand.AllInEquity()
+	if errors.Is(err, ErrNoAllIn) {
+		return nil, nil
+	}
+	if err != nil {
+		report.Skipped++
+		return nil, err
+	}
+
+	report.AllInHands++
+	for _, player := range equity.Players {
+		result := report.player(player.Player)
+		result.AllInHands++
+		result.AllInNet += player.Net()
+		result.AllInExpectedNet += player.ExpectedNet()
+	}
+	return equity, nil
+}
+
+// Adds the hands of another report to this one
+func (report *SessionReport) Merge(other *SessionReport) {
+	report.Hands += other.Hands
+	report.AllInHands += other.AllInHands
+	report.Skipped += other.Skipped
+	for name, from := range other.Players {
+		result := report.player(name)
+		result.Hands += from.Hands
+		result.Net += from.Net
+		result.AllInHands += from.AllInHands
+		result.AllInNet += from.AllInNet
+		result.AllInExpectedNet += from.AllInExpectedNet
+	}
+}
+
+// The players sorted by name
+func (report *SessionReport) Results() []*PlayerResult {
+	results := make([]*PlayerResult, 0, len(report.Players))
+	for _, result := range report.Players {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Player < results[j].Player })
+	return results
+}
+
+// Writes the report as a table with a line per player
+func (report *SessionReport) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "%d hands, %d all in, %d skipped\n", report.Hands, report.AllInHands, report.Skipped); err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(table, "Player\tHands\tNet\tAll in\tAll in net\tAll in EV\tLuck\tAdjusted net\t\n")
+	for _, result := range report.Results() {
+		fmt.Fprintf(table, "%s\t%d\t%s\t%d\t%s\t%s\t%s\t%s\t\n", result.Player, result.Hands, result.Net,
+			result.AllInHands, result.AllInNet, result.AllInExpectedNet, result.Luck(), result.AdjustedNet())
+	}
+	return table.Flush()
+}
+
+func (report *SessionReport) player(name string) *PlayerResult {
+	result := report.Players[name]
+	if result == nil {
+		result = &PlayerResult{Player: name}
+		report.Players[name] = result
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package handHistory
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"holdemHand"
+)
+
+var (
+	ErrNoShowdown = errors.New("The hand has no showdown")
+	ErrWrongAward = errors.New("The pot was awarded to the wrong players")
+)
+
+// A hand shown at showdown
+type ShownHand struct {
+	Player string
+	Value  uint
+}
+
+// Evaluates the hands shown at showdown with EvaluateMask() and checks the main
+// pot went to the best of them. Returns the shown hands, best first. Players who
+// mucked can't win so only the shown hands are compared, and the hero's cards
+// only count when the hero didn't muck. Only hold'em is supported.
+func (hand *Hand) VerifyShowdown() ([]ShownHand, error) {
+	if !strings.HasPrefix(hand.Game, "Hold'em") {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, hand.Game)
+	}
+
+	shown, err := hand.shownHands(hand.ActivePlayers())
+	if err != nil {
+		return nil, err
+	}
+	if len(hand.Board) != 5 || len(hand.ActivePlayers()) < 2 || len(shown) == 0 {
+		return nil, ErrNoShowdown
+	}
+
+	best := bestPlayers(shown)
+	awarded := hand.potWinners(0)
+	if !slices.Equal(best, awarded) {
+		return shown, fmt.Errorf("%w: %s won with %s but the pot went to %s", ErrWrongAward,
+			strings.Join(best, ", "), holdemHand.HandValue(shown[0].Value), strings.Join(awarded, ", "))
+	}
+	return shown, nil
+}
+
+// the hands shown by the given players with the board, best first, leaving out
+// the players who mucked
+func (hand *Hand) shownHands(players []string) ([]ShownHand, error) {
+	mucked := map[string]bool{}
+	for _, action := range hand.Actions {
+		if action.Type == Muck {
+			mucked[action.Player] = true
+		}
+	}
+
+	shown := []ShownHand{}
+	for _, name := range players {
+		player := hand.Player(name)
+		if player == nil || mucked[name] || len(player.HoleCards) != 2 {
+			continue
+		}
+		value, err := holdemHand.EvaluateMask(player.HoleCards.Mask() | hand.Board.Mask())
+		if err != nil {
+			return nil, err
+		}
+		shown = append(shown, ShownHand{name, value})
+	}
+	slices.SortStableFunc(shown, func(a, b ShownHand) int {
+		return int(b.Value) - int(a.Value)
+	})
+	return shown, nil
+}
+
+// the players with the best of the shown hands, sorted by name
+func bestPlayers(shown []ShownHand) []string {
+	best := []string{}
+	for _, s := range shown {
+		if s.Value == shown[0].Value {
+			best = append(best, s.Player)
+		}
+	}
+	slices.Sort(best)
+	return best
+}
+
+// the players who won a share of a pot, sorted by name
+func (hand *Hand) potWinners(pot int) []string {
+	winners := []string{}
+	for _, winning := range hand.Winnings {
+		if winning.Pot == pot && !slices.Contains(winners, winning.Player) {
+			winners = append(winners, winning.Player)
+		}
+	}
+	slices.Sort(winners)
+	return winners
+}
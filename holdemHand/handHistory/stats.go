@@ -0,0 +1,391 @@
+package handHistory
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Where a player sat relative to the button
+type Position int
+
+const (
+	// the first seats to act preflop
+	Early Position = iota
+	Middle
+	Hijack
+	Cutoff
+	Button
+	SmallBlind
+	BigBlind
+)
+
+var PositionNames = [...]string{"EP", "MP", "HJ", "CO", "BTN", "SB", "BB"}
+
+// A count of how often a player did something out of the times they could have
+type Ratio struct {
+	Count         int
+	Opportunities int
+}
+
+// The usual HUD statistics for a player over a set of hands
+type PlayerStats struct {
+	Player string
+	Hands  int
+	// what the player won less what they put in, and the same in big blinds
+	Net          Amount
+	NetBigBlinds float64
+	// voluntarily put money in the pot preflop
+	VPIP Ratio
+	// raised preflop
+	PFR Ratio
+	// re-raised the first raise preflop
+	ThreeBet Ratio
+	// folded the first raise to a re-raise
+	FoldToThreeBet Ratio
+	// bet the flop after making the last raise preflop
+	ContinuationBet       Ratio
+	FoldToContinuationBet Ratio
+	// went to showdown after seeing the flop
+	WentToShowdown Ratio
+	// won money at showdown
+	WonAtShowdown Ratio
+	// won money after seeing the flop
+	WonWhenSawFlop Ratio
+	// bets, raises and calls after the flop, for the aggression factor
+	PostflopAggressive int
+	PostflopCalls      int
+}
+
+// Which hands and seats are counted. The zero value counts everything.
+type StatsFilter struct {
+	// count only the hands played from these positions, any position when empty
+	Positions []Position
+	// count only the hands where the player's starting stack, in big blinds, is at
+	// least MinStack and below MaxStack, zero for no limit
+	MinStack float64
+	MaxStack float64
+	// count only the hands played from From and before To, zero for no limit
+	From time.Time
+	To   time.Time
+}
+
+// Statistics for every player over a set of hands
+type Stats struct {
+	Filter  StatsFilter
+	Players map[string]*PlayerStats
+}
+
+func (position Position) String() string {
+	if position < 0 || int(position) >= len(PositionNames) {
+		return ""
+	}
+	return PositionNames[position]
+}
+
+// The count as a percentage of the opportunities, zero without any
+func (ratio Ratio) Percent() float64 {
+	if ratio.Opportunities == 0 {
+		return 0
+	}
+	return 100 * float64(ratio.Count) / float64(ratio.Opportunities)
+}
+
+func (ratio *Ratio) add(opportunity bool, count bool) {
+	if opportunity {
+		ratio.Opportunities++
+		if count {
+			ratio.Count++
+		}
+	}
+}
+
+func (ratio *Ratio) merge(other Ratio) {
+	ratio.Count += other.Count
+	ratio.Opportunities += other.Opportunities
+}
+
+// Postflop bets and raises divided by calls, zero without calls
+func (stats *PlayerStats) AggressionFactor() float64 {
+	if stats.PostflopCalls == 0 {
+		return 0
+	}
+	return float64(stats.PostflopAggressive) / float64(stats.PostflopCalls)
+}
+
+// Big blinds won per 100 hands
+func (stats *PlayerStats) BigBlindsPer100() float64 {
+	if stats.Hands == 0 {
+		return 0
+	}
+	return 100 * stats.NetBigBlinds / float64(stats.Hands)
+}
+
+// Returns the position of every player dealt into the hand. The players between the
+// big blind and the hijack are split between early and middle position, the early
+// seats getting the extra one. Heads-up the small blind is the button.
+func (hand *Hand) Positions() map[string]Position {
+	state := newReplayState(hand)
+	positions := map[string]Position{}
+	if len(state.order) == 0 {
+		return positions
+	}
+
+	for _, action := range hand.Actions {
+		switch action.Type {
+		case PostSmallBlind:
+			positions[action.Player] = SmallBlind
+		case PostBigBlind:
+			positions[action.Player] = BigBlind
+		}
+	}
+	button := state.buttonPlayer()
+	positions[button] = Button
+
+	// the rest in the order they act, from the left of the button
+	start := slices.Index(state.order, button)
+	rest := []string{}
+	for i := 1; i < len(state.order); i++ {
+		name := state.order[(start+i)%len(state.order)]
+		if _, ok := positions[name]; !ok {
+			rest = append(rest, name)
+		}
+	}
+	for i, name := range rest {
+		switch fromButton := len(rest) - i; {
+		case fromButton == 1:
+			positions[name] = Cutoff
+		case fromButton == 2:
+			positions[name] = Hijack
+		case i < (len(rest)-1)/2:
+			positions[name] = Early
+		default:
+			positions[name] = Middle
+		}
+	}
+	return positions
+}
+
+func NewStats(filter StatsFilter) *Stats {
+	return &Stats{Filter: filter, Players: map[string]*PlayerStats{}}
+}
+
+// Works out the statistics of every player over the hands
+func ComputeStats(hands []*Hand, filter StatsFilter) *Stats {
+	stats := NewStats(filter)
+	for _, hand := range hands {
+		stats.Add(hand)
+	}
+	return stats
+}
+
+// Adds a hand to the statistics of the players it passes the filter for
+func (stats *Stats) Add(hand *Hand) {
+	filter := stats.Filter
+	if (!filter.From.IsZero() && hand.Time.Before(filter.From)) || (!filter.To.IsZero() && !hand.Time.Before(filter.To)) {
+		return
+	}
+
+	positions := hand.Positions()
+	counted := map[string]bool{}
+	for _, player := range hand.Players {
+		position, ok := positions[player.Name]
+		if !ok || (len(filter.Positions) > 0 && !slices.Contains(filter.Positions, position)) {
+			continue
+		}
+		if hand.BigBlind > 0 {
+			depth := float64(player.Stack) / float64(hand.BigBlind)
+			if (filter.MinStack > 0 && depth < filter.MinStack) || (filter.MaxStack > 0 && depth >= filter.MaxStack) {
+				continue
+			}
+		}
+		counted[player.Name] = true
+	}
+	if len(counted) == 0 {
+		return
+	}
+
+	// preflop: who put money in, who raised and who faced a re-raise
+	vpip, pfr := map[string]bool{}, map[string]bool{}
+	threeBetChance, threeBet := map[string]bool{}, map[string]bool{}
+	facedThreeBet, foldedToThreeBet := false, false
+	raises := 0
+	opener, aggressor := "", ""
+	folded := map[string]Street{}
+
+	// the flop: whether the preflop aggressor bet first and how the others answered
+	cbetChance, cbet := false, false
+	cbetBy, facedCbet, foldedToCbet := "", map[string]bool{}, map[string]bool{}
+	betOnFlop, raisedCbet := false, false
+	aggressive, calls := map[string]int{}, map[string]int{}
+
+	for _, action := range hand.Actions {
+		name := action.Player
+		if action.Type < Fold || action.Type > Raise {
+			continue
+		}
+		if action.Type == Fold {
+			folded[name] = action.Street
+		}
+
+		if action.Street == Preflop {
+			if _, ok := threeBetChance[name]; !ok && raises == 1 && name != opener {
+				threeBetChance[name] = true
+				threeBet[name] = action.Type == Raise || action.Type == Bet
+			}
+			if raises == 2 && name == opener && !facedThreeBet {
+				facedThreeBet = true
+				foldedToThreeBet = action.Type == Fold
+			}
+			if action.Type >= Call {
+				vpip[name] = true
+			}
+			if action.Type == Raise || action.Type == Bet {
+				pfr[name] = true
+				raises++
+				aggressor = name
+				if opener == "" {
+					opener = name
+				}
+			}
+			continue
+		}
+
+		switch action.Type {
+		case Bet, Raise:
+			aggressive[name]++
+		case Call:
+			calls[name]++
+		}
+
+		if action.Street != Flop {
+			continue
+		}
+		if name == aggressor && !betOnFlop && !cbetChance {
+			cbetChance = true
+			cbet = action.Type == Bet
+			if cbet {
+				cbetBy = name
+			}
+		}
+		if cbetBy != "" && name != cbetBy && !raisedCbet && !facedCbet[name] {
+			facedCbet[name] = true
+			foldedToCbet[name] = action.Type == Fold
+		}
+		if action.Type == Bet || action.Type == Raise {
+			betOnFlop = true
+			// after a raise the players behind face the raise rather than the continuation bet
+			raisedCbet = raisedCbet || (cbetBy != "" && action.Type == Raise)
+		}
+	}
+
+	active := hand.ActivePlayers()
+	showdown := len(active) >= 2
+	sawFlop := func(name string) bool {
+		street, ok := folded[name]
+		return len(hand.Board) >= 3 && (!ok || street > Preflop)
+	}
+
+	for name := range counted {
+		player := stats.player(name)
+		net := hand.Won(name) - hand.Invested(name)
+		player.Hands++
+		player.Net += net
+		if hand.BigBlind > 0 {
+			player.NetBigBlinds += float64(net) / float64(hand.BigBlind)
+		}
+
+		player.VPIP.add(true, vpip[name])
+		player.PFR.add(true, pfr[name])
+		player.ThreeBet.add(threeBetChance[name], threeBet[name])
+		player.FoldToThreeBet.add(name == opener && facedThreeBet, foldedToThreeBet)
+		player.ContinuationBet.add(name == aggressor && cbetChance, cbet)
+		player.FoldToContinuationBet.add(facedCbet[name], foldedToCbet[name])
+
+		wentToShowdown := showdown && slices.Contains(active, name)
+		player.WentToShowdown.add(sawFlop(name), wentToShowdown)
+		player.WonAtShowdown.add(wentToShowdown, hand.Won(name) > 0)
+		player.WonWhenSawFlop.add(sawFlop(name), hand.Won(name) > 0)
+		player.PostflopAggressive += aggressive[name]
+		player.PostflopCalls += calls[name]
+	}
+}
+
+// Adds the hands of other statistics to these
+func (stats *Stats) Merge(other *Stats) {
+	for name, from := range other.Players {
+		player := stats.player(name)
+		player.Hands += from.Hands
+		player.Net += from.Net
+		player.NetBigBlinds += from.NetBigBlinds
+		player.VPIP.merge(from.VPIP)
+		player.PFR.merge(from.PFR)
+		player.ThreeBet.merge(from.ThreeBet)
+		player.FoldToThreeBet.merge(from.FoldToThreeBet)
+		player.ContinuationBet.merge(from.ContinuationBet)
+		player.FoldToContinuationBet.merge(from.FoldToContinuationBet)
+		player.WentToShowdown.merge(from.WentToShowdown)
+		player.WonAtShowdown.merge(from.WonAtShowdown)
+		player.WonWhenSawFlop.merge(from.WonWhenSawFlop)
+		player.PostflopAggressive += from.PostflopAggressive
+		player.PostflopCalls += from.PostflopCalls
+	}
+}
+
+// The players sorted by name
+func (stats *Stats) Results() []*PlayerStats {
+	results := make([]*PlayerStats, 0, len(stats.Players))
+	for _, player := range stats.Players {
+		results = append(results, player)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Player < results[j].Player })
+	return results
+}
+
+// Writes a CSV file with a header and a line per player. Percentages have one
+// decimal and are left empty when the player never had the chance.
+func (stats *Stats) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"player", "hands", "net", "bb_per_100", "vpip", "pfr", "3bet", "fold_to_3bet",
+		"cbet", "fold_to_cbet", "wtsd", "wsd", "wwsf", "af"})
+
+	percent := func(ratio Ratio) string {
+		if ratio.Opportunities == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(ratio.Percent(), 'f', 1, 64)
+	}
+	for _, player := range stats.Results() {
+		writer.Write([]string{
+			player.Player,
+			strconv.Itoa(player.Hands),
+			player.Net.String(),
+			strconv.FormatFloat(player.BigBlindsPer100(), 'f', 2, 64),
+			percent(player.VPIP),
+			percent(player.PFR),
+			percent(player.ThreeBet),
+			percent(player.FoldToThreeBet),
+			percent(player.ContinuationBet),
+			percent(player.FoldToContinuationBet),
+			percent(player.WentToShowdown),
+			percent(player.WonAtShowdown),
+			percent(player.WonWhenSawFlop),
+			fmt.Sprintf("%.2f", player.AggressionFactor()),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (stats *Stats) player(name string) *PlayerStats {
+	player := stats.Players[name]
+	if player == nil {
+		player = &PlayerStats{Player: name}
+		stats.Players[name] = player
+	}
+	return player
+}
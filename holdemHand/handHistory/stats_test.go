@@ -0,0 +1,121 @@
+package handHistory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPositions(t *testing.T) {
+	hands := readSampleHands(t)
+
+	tests := []map[string]Position{
+		{"Alice": Button, "Bob": SmallBlind, "Carol": BigBlind, "Dan:2": Cutoff},
+		{"Alice": BigBlind, "Bob": Button, "Carol": SmallBlind},
+		{"Alice": Button, "Bob": BigBlind},
+		{"Frank": Button, "Gina": SmallBlind, "Hank": BigBlind, "Ivy": Cutoff},
+	}
+	for i, want := range tests {
+		if got := hands[i].Positions(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect positions for hand %d. Want %v, Got %v", i, want, got)
+		}
+	}
+
+	// nine handed: two early seats and two middle seats before the hijack
+	hand := &Hand{Button: 9}
+	for seat := 1; seat <= 9; seat++ {
+		name := string(rune('A' + seat - 1))
+		hand.Players = append(hand.Players, Player{Seat: seat, Name: name})
+		hand.Actions = append(hand.Actions, Action{Player: name, Type: Fold})
+	}
+	hand.Actions[0].Type, hand.Actions[1].Type = PostSmallBlind, PostBigBlind
+	got := ""
+	for _, player := range hand.Players {
+		got += hand.Positions()[player.Name].String() + " "
+	}
+	if got != "SB BB EP EP MP MP HJ CO BTN " {
+		t.Fatalf("Incorrect nine handed positions %q", got)
+	}
+}
+
+func TestStats(t *testing.T) {
+	hands := readSampleHands(t)
+	stats := ComputeStats(hands, StatsFilter{})
+
+	alice, bob, carol := stats.Players["Alice"], stats.Players["Bob"], stats.Players["Carol"]
+	if alice.Hands != 3 || bob.Hands != 3 || carol.Hands != 2 || stats.Players["Dan:2"].Hands != 1 {
+		t.Fatalf("Incorrect hand counts")
+	}
+	if alice.VPIP != (Ratio{2, 3}) || alice.PFR != (Ratio{1, 3}) || carol.VPIP != (Ratio{2, 2}) || carol.PFR != (Ratio{1, 2}) {
+		t.Fatalf("Incorrect VPIP or PFR %+v %+v", alice, carol)
+	}
+	if carol.ThreeBet != (Ratio{1, 2}) || bob.ThreeBet != (Ratio{0, 1}) || alice.ThreeBet != (Ratio{0, 0}) {
+		t.Fatalf("Incorrect 3bet %+v %+v %+v", alice.ThreeBet, bob.ThreeBet, carol.ThreeBet)
+	}
+	if bob.FoldToThreeBet != (Ratio{0, 1}) || alice.FoldToThreeBet != (Ratio{0, 0}) {
+		t.Fatalf("Incorrect fold to 3bet %+v", bob.FoldToThreeBet)
+	}
+	if alice.ContinuationBet != (Ratio{1, 1}) || carol.FoldToContinuationBet != (Ratio{0, 1}) {
+		t.Fatalf("Incorrect continuation bets %+v %+v", alice.ContinuationBet, carol.FoldToContinuationBet)
+	}
+	if alice.WentToShowdown != (Ratio{2, 2}) || alice.WonAtShowdown != (Ratio{1, 2}) || carol.WonWhenSawFlop != (Ratio{1, 2}) {
+		t.Fatalf("Incorrect showdown stats %+v %+v", alice, carol)
+	}
+	if alice.PostflopAggressive != 1 || alice.PostflopCalls != 1 || carol.AggressionFactor() != 1 {
+		t.Fatalf("Incorrect aggression %+v %+v", alice, carol)
+	}
+	if bob.Net != 100000-5+5 || bob.NetBigBlinds != 10-0.5+0.5 {
+		t.Fatalf("Incorrect net for Bob %v, %v", bob.Net, bob.NetBigBlinds)
+	}
+
+	merged := NewStats(StatsFilter{})
+	merged.Merge(stats)
+	merged.Merge(stats)
+	if merged.Players["Alice"].Hands != 6 || merged.Players["Alice"].VPIP != (Ratio{4, 6}) {
+		t.Fatalf("Incorrect merged stats %+v", merged.Players["Alice"])
+	}
+}
+
+func TestStatsFilters(t *testing.T) {
+	hands := readSampleHands(t)
+
+	tests := []struct {
+		filter StatsFilter
+		want   map[string]int
+	}{
+		{StatsFilter{Positions: []Position{Button}}, map[string]int{"Alice": 2, "Bob": 1, "Frank": 1}},
+		{StatsFilter{Positions: []Position{SmallBlind, BigBlind}}, map[string]int{"Alice": 1, "Bob": 2, "Carol": 2, "Gina": 1, "Hank": 1}},
+		{StatsFilter{MinStack: 50}, map[string]int{"Alice": 2, "Bob": 2, "Carol": 2, "Dan:2": 1,
+			"Frank": 1, "Gina": 1, "Hank": 1, "Ivy": 1}},
+		{StatsFilter{MaxStack: 50}, map[string]int{"Alice": 1, "Bob": 1}},
+		{StatsFilter{To: time.Date(2023, 1, 16, 1, 45, 0, 0, time.UTC)}, map[string]int{"Alice": 2, "Bob": 2, "Carol": 1,
+			"Dan:2": 1, "Frank": 1, "Gina": 1, "Hank": 1, "Ivy": 1}},
+		{StatsFilter{From: time.Date(2023, 1, 16, 2, 0, 0, 0, time.UTC)}, map[string]int{"Alice": 1, "Bob": 1, "Carol": 1}},
+	}
+
+	for i, test := range tests {
+		got := map[string]int{}
+		for name, player := range ComputeStats(hands, test.filter).Players {
+			got[name] = player.Hands
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("Incorrect hands for filter %d. Want %v, Got %v", i, test.want, got)
+		}
+	}
+}
+
+func TestStatsCSV(t *testing.T) {
+	var text strings.Builder
+	if err := ComputeStats(readSampleHands(t), StatsFilter{}).WriteCSV(&text); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	if len(lines) != 9 || lines[0] != "player,hands,net,bb_per_100,vpip,pfr,3bet,fold_to_3bet,cbet,fold_to_cbet,wtsd,wsd,wwsf,af" {
+		t.Fatalf("Incorrect CSV\n%s", text.String())
+	}
+	if lines[4] != "Dan:2,1,0,0.00,0.0,0.0,,,,,,,,0.00" {
+		t.Fatalf("Incorrect CSV line %q", lines[4])
+	}
+}
@@ -0,0 +1,54 @@
+package holdemHand
+
+import (
+	"errors"
+	"testing"
+)
+
+func assertNoAllocs(t *testing.T, name string, f func()) {
+	if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+		t.Fatalf("%s allocates %.1f times per run, want 0", name, allocs)
+	}
+}
+
+func TestEvaluateAllocs(t *testing.T) {
+	mask, _ := ParseHand("Ah Ad Ac Kh Kd 2c 2d")
+	flush, _ := ParseHand("8d 9d As Kd Jd 7d Td")
+
+	assertNoAllocs(t, "EvaluateMask", func() { EvaluateMask(mask) })
+	assertNoAllocs(t, "EvaluateMask with a flush", func() { EvaluateMask(flush) })
+	assertNoAllocs(t, "EvaluateMask with no cards", func() { EvaluateMask(0) })
+	assertNoAllocs(t, "EvaluateMaskUnchecked", func() { EvaluateMaskUnchecked(flush) })
+	assertNoAllocs(t, "EvaluateType", func() { EvaluateType(mask) })
+	assertNoAllocs(t, "bitCount", func() { bitCount(mask) })
+}
+
+func TestParseAllocs(t *testing.T) {
+	assertNoAllocs(t, "ParseHand", func() { ParseHand("Ah Ad Ac Kh Kd 2c 10d") })
+	assertNoAllocs(t, "ParseHand with a bad hand", func() { ParseHand("Ah Ah") })
+	assertNoAllocs(t, "ParseCard", func() { ParseCard("Qs") })
+	assertNoAllocs(t, "ValidateHand", func() { ValidateHand("Ah Ad Ac Kh Kd 2c 2d") })
+	assertNoAllocs(t, "EvaluateHandText", func() { EvaluateHandText("Ah Ad Ac Kh Kd 2c 2d") })
+}
+
+func TestAppendMaskStringAllocs(t *testing.T) {
+	mask, _ := ParseHand("Ah Ad Ac Kh Kd 2c 2d")
+	buf := make([]byte, 0, 64)
+	assertNoAllocs(t, "AppendMaskString", func() { buf = AppendMaskString(buf[:0], mask) })
+
+	if string(buf) != MaskToString(mask) {
+		t.Fatalf("AppendMaskString() and MaskToString() differ: %s, %s", buf, MaskToString(mask))
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	if _, err := EvaluateMask(0); !errors.Is(err, ErrInvalidCardCount) {
+		t.Fatalf("Expecting ErrInvalidCardCount, got %v", err)
+	}
+	if _, err := EvaluateHandText("Ah Ah"); !errors.Is(err, ErrBadHand) {
+		t.Fatalf("Expecting ErrBadHand, got %v", err)
+	}
+	if _, err := CardRank(-1); !errors.Is(err, ErrInvalidCard) {
+		t.Fatalf("Expecting ErrInvalidCard, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package holdemHand
+
+// Evaluates each mask in masks into the same position in out, which must be at least
+// as long as masks. This is the same as calling EvaluateMask() on every mask without
+// the cost of an error per hand: masks with an invalid number of cards, the joker or
+// bits above the deck get a hand value of zero and have their bit set in the invalid
+// bitset (bit i%64 of invalid[i/64]). invalid can be nil, otherwise it must have room
+// for a bit per mask. Returns the number of invalid masks.
+func EvaluateBatch(masks []uint64, out []HandValue, invalid []uint64) int {
+	out = out[:len(masks)]
+	if invalid != nil {
+		invalid = invalid[:(len(masks)+63)/64]
+		clear(invalid)
+	}
+
+	count := 0
+	for i, mask := range masks {
+		numCards := bitCount(mask)
+		if numCards < 1 || numCards > 7 || mask>>NumberOfCards != 0 {
+			out[i] = 0
+			count++
+			if invalid != nil {
+				invalid[i/64] |= uint64(1) << (i % 64)
+			}
+			continue
+		}
+		out[i] = HandValue(evaluateMask(mask, numCards))
+	}
+
+	return count
+}
+
+// Same as EvaluateBatch() for EvaluateType(). Invalid masks get a hand type of HighCard.
+func EvaluateTypeBatch(masks []uint64, out []int, invalid []uint64) int {
+	out = out[:len(masks)]
+	if invalid != nil {
+		invalid = invalid[:(len(masks)+63)/64]
+		clear(invalid)
+	}
+
+	count := 0
+	for i, mask := range masks {
+		numCards := bitCount(mask)
+		if numCards < 1 || numCards > 7 || mask>>NumberOfCards != 0 {
+			out[i] = HighCard
+			count++
+			if invalid != nil {
+				invalid[i/64] |= uint64(1) << (i % 64)
+			}
+			continue
+		}
+		out[i] = EvaluateType(mask)
+	}
+
+	return count
+}
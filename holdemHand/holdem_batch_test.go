@@ -0,0 +1,104 @@
+package holdemHand
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// a fixed set of random seven card hands
+func batchMasks(count int) []uint64 {
+	return randomMasks(count, 7)
+}
+
+// a fixed set of random hands with the given number of cards
+func randomMasks(count int, numCards uint) []uint64 {
+	random := rand.New(rand.NewSource(1))
+	masks := make([]uint64, count)
+	for i := range masks {
+		for bitCount(masks[i]) < numCards {
+			masks[i] |= CardMasksTable[random.Intn(CardsMasksTableSize)]
+		}
+	}
+	return masks
+}
+
+func TestEvaluateBatch(t *testing.T) {
+	masks := batchMasks(1000)
+	masks[3] = 0
+	masks[70], _ = ParseHand("2c 3c 4c 5c 6c 7c 8c 9c")
+	masks[130], _ = ParseHand("As Ks Xx")
+	masks[200] |= uint64(1) << 60
+
+	out := make([]HandValue, len(masks))
+	invalid := make([]uint64, (len(masks)+63)/64)
+	invalid[0] = 0xFF
+	if count := EvaluateBatch(masks, out, invalid); count != 4 {
+		t.Fatalf("Expecting 4 invalid masks, got %d", count)
+	}
+	if invalid[0] != 1<<3 || invalid[1] != 1<<(70-64) || invalid[2] != 1<<(130-128) ||
+		invalid[3] != 1<<(200-192) || invalid[4] != 0 {
+		t.Fatalf("Incorrect invalid bitset %#x", invalid)
+	}
+
+	types := make([]int, len(masks))
+	if count := EvaluateTypeBatch(masks, types, nil); count != 4 {
+		t.Fatalf("Expecting 4 invalid masks, got %d", count)
+	}
+
+	for i, mask := range masks {
+		want, err := EvaluateMask(mask)
+		if err != nil {
+			want = 0
+		}
+		if uint(out[i]) != want {
+			t.Fatalf("Incorrect hand value for %s. Want %#x, Got %#x", MaskToString(mask), want, out[i])
+		}
+		if err == nil && types[i] != EvaluateType(mask) {
+			t.Fatalf("Incorrect hand type for %s", MaskToString(mask))
+		}
+	}
+}
+
+func BenchmarkEvaluateBatch(b *testing.B) {
+	masks := batchMasks(1 << 16)
+	out := make([]HandValue, len(masks))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateBatch(masks, out, nil)
+	}
+	b.ReportMetric(float64(b.N*len(masks))/b.Elapsed().Seconds(), "hands/s")
+}
+
+func BenchmarkEvaluateMaskPerHand(b *testing.B) {
+	masks := batchMasks(1 << 16)
+	out := make([]uint, len(masks))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, mask := range masks {
+			out[j], _ = EvaluateMask(mask)
+		}
+	}
+	b.ReportMetric(float64(b.N*len(masks))/b.Elapsed().Seconds(), "hands/s")
+}
+
+func BenchmarkEvaluateTypeBatch(b *testing.B) {
+	masks := batchMasks(1 << 16)
+	out := make([]int, len(masks))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateTypeBatch(masks, out, nil)
+	}
+	b.ReportMetric(float64(b.N*len(masks))/b.Elapsed().Seconds(), "hands/s")
+}
+
+func BenchmarkEvaluateTypePerHand(b *testing.B) {
+	masks := batchMasks(1 << 16)
+	out := make([]int, len(masks))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, mask := range masks {
+			out[j] = EvaluateType(mask)
+		}
+	}
+	b.ReportMetric(float64(b.N*len(masks))/b.Elapsed().Seconds(), "hands/s")
+}
@@ -0,0 +1,181 @@
+package holdemHand
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// Benchmarks are named so runs can be compared with benchstat, e.g.
+//
+//	go test -run '^$' -bench . -count 10 > old.txt
+//	go test -run '^$' -bench . -count 10 > new.txt
+//	benchstat old.txt new.txt
+//
+// and implementations of the same thing with benchstat -col /impl.
+
+var (
+	benchCardCounts = []uint{5, 6, 7}
+	// keeps the compiler from optimizing away the work
+	benchSink uint
+)
+
+func reportHands(b *testing.B, hands int) {
+	b.ReportMetric(float64(hands)/b.Elapsed().Seconds(), "hands/s")
+}
+
+func BenchmarkEvaluateMask(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		b.Run(fmt.Sprintf("cards=%d", numCards), func(b *testing.B) {
+			masks := randomMasks(1<<12, numCards)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				value, _ := EvaluateMask(masks[i&(len(masks)-1)])
+				benchSink += value
+			}
+			reportHands(b, b.N)
+		})
+	}
+}
+
+func BenchmarkEvaluateType(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		b.Run(fmt.Sprintf("cards=%d", numCards), func(b *testing.B) {
+			masks := randomMasks(1<<12, numCards)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				benchSink += uint(EvaluateType(masks[i&(len(masks)-1)]))
+			}
+			reportHands(b, b.N)
+		})
+	}
+}
+
+func BenchmarkEvaluate7(b *testing.B) {
+	evaluator, err := DefaultLookupEvaluator()
+	if err != nil {
+		b.Fatal(err)
+	}
+	masks := randomMasks(1<<12, 7)
+
+	b.Run("impl=mask", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink += EvaluateMaskUnchecked(masks[i&(len(masks)-1)])
+		}
+		reportHands(b, b.N)
+	})
+
+	b.Run("impl=lookup", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink += evaluator.Evaluate7(masks[i&(len(masks)-1)])
+		}
+		reportHands(b, b.N)
+	})
+}
+
+func BenchmarkParseHand(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		b.Run(fmt.Sprintf("cards=%d", numCards), func(b *testing.B) {
+			masks := randomMasks(1<<8, numCards)
+			hands := make([]string, len(masks))
+			for i, mask := range masks {
+				hands[i] = MaskToString(mask)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				mask, _ := ParseHand(hands[i&(len(hands)-1)])
+				benchSink += uint(mask)
+			}
+			reportHands(b, b.N)
+		})
+	}
+}
+
+func BenchmarkMaskToString(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		b.Run(fmt.Sprintf("cards=%d", numCards), func(b *testing.B) {
+			masks := randomMasks(1<<8, numCards)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				benchSink += uint(len(MaskToString(masks[i&(len(masks)-1)])))
+			}
+			reportHands(b, b.N)
+		})
+	}
+}
+
+// Enumerating every hand without evaluating them. The channel based HandsRange()
+// is left out of the seven card run, it takes minutes.
+func BenchmarkHandsRange(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		hands := int(Binomial(NumberOfCards, int(numCards)))
+
+		if numCards < 7 {
+			b.Run(fmt.Sprintf("impl=channel/cards=%d", numCards), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for mask := range HandsRange(int(numCards)) {
+						benchSink += uint(mask)
+					}
+				}
+				reportHands(b, b.N*hands)
+			})
+		}
+
+		b.Run(fmt.Sprintf("impl=callback/cards=%d", numCards), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				HandsRange2(int(numCards), func(mask uint64) {
+					benchSink += uint(mask)
+				})
+			}
+			reportHands(b, b.N*hands)
+		})
+
+		b.Run(fmt.Sprintf("impl=slice/cards=%d", numCards), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				HandsRangeSlice(int(numCards), 0, uint64(hands), func(mask uint64) {
+					benchSink += uint(mask)
+				})
+			}
+			reportHands(b, b.N*hands)
+		})
+	}
+}
+
+// Enumerating and evaluating every hand, as in the console test bed.
+func BenchmarkEnumerateEvaluate(b *testing.B) {
+	for _, numCards := range benchCardCounts {
+		hands := int(Binomial(NumberOfCards, int(numCards)))
+
+		b.Run(fmt.Sprintf("impl=serial/cards=%d", numCards), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var handTypes [StraightFlush + 1]int
+				HandsRange2(int(numCards), func(mask uint64) {
+					handTypes[EvaluateType(mask)]++
+				})
+				benchSink += uint(handTypes[HighCard])
+			}
+			reportHands(b, b.N*hands)
+		})
+
+		b.Run(fmt.Sprintf("impl=parallel/cards=%d", numCards), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				handTypes, _ := HandsRangeParallel(context.Background(), int(numCards), ParallelOptions{},
+					func() *[StraightFlush + 1]int { return &[StraightFlush + 1]int{} },
+					func(handTypes *[StraightFlush + 1]int, mask uint64) { handTypes[EvaluateType(mask)]++ },
+					func(dst *[StraightFlush + 1]int, src *[StraightFlush + 1]int) {
+						for i := range dst {
+							dst[i] += src[i]
+						}
+					})
+				benchSink += uint(handTypes[HighCard])
+			}
+			reportHands(b, b.N*hands)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package holdemHand
+
+import (
+	"context"
+	"math/bits"
+	"time"
+)
+
+// How far a long running computation has got
+type Progress struct {
+	// number of hands (or boards) processed so far
+	Processed uint64
+	// number of hands that will be processed
+	Total uint64
+	// time since the computation started
+	Elapsed time.Duration
+	// estimated time until the computation is done, based on the rate so far
+	ETA time.Duration
+}
+
+const (
+	// hands between checks for cancellation
+	progressCheckInterval = 1 << 14
+	// minimum time between progress reports
+	progressReportInterval = 100 * time.Millisecond
+)
+
+// Same as HandsRange2() but stops early when the context is cancelled, returning the
+// context's error. progress, which can be nil, is called every so often with the
+// number of hands enumerated so far and once more when all the hands are done.
+func HandsRangeContext(ctx context.Context, numCards int, callback func(uint64), progress func(Progress)) error {
+	return DeckHandsRangeContext(ctx, FullDeck, 0, 0, numCards, callback, progress)
+}
+
+// Same as DeckHandsRange() but stops early when the context is cancelled, see HandsRangeContext().
+func DeckHandsRangeContext(ctx context.Context, deck uint64, shared uint64, dead uint64, numCards int,
+	callback func(uint64), progress func(Progress)) error {
+	n := numCards - bits.OnesCount64(shared)
+	if n < 0 {
+		return ctx.Err()
+	}
+
+	cards := deckCards(deck &^ (dead | shared))
+	tracker := newProgressTracker(ctx, progress, Binomial(len(cards), n))
+	handsRangeCardsUntil(cards, shared, n, func(mask uint64) bool {
+		callback(mask)
+		return tracker.step()
+	})
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tracker.done()
+	return nil
+}
+
+// same as handsRangeCards() but stops as soon as the callback returns false
+func handsRangeCardsUntil(cards []uint64, mask uint64, numCards int, callback func(uint64) bool) bool {
+	if numCards == 0 {
+		return callback(mask)
+	}
+
+	for a := 0; a <= len(cards)-numCards; a++ {
+		if !handsRangeCardsUntil(cards[a+1:], mask|cards[a], numCards-1, callback) {
+			return false
+		}
+	}
+	return true
+}
+
+// counts the hands processed by a long running computation, checks for
+// cancellation and reports progress
+type progressTracker struct {
+	ctx        context.Context
+	report     func(Progress)
+	start      time.Time
+	lastReport time.Time
+	progress   Progress
+	pending    uint64
+}
+
+func newProgressTracker(ctx context.Context, report func(Progress), total uint64) *progressTracker {
+	start := time.Now()
+	return &progressTracker{
+		ctx:        ctx,
+		report:     report,
+		start:      start,
+		lastReport: start,
+		progress:   Progress{Total: total},
+	}
+}
+
+// counts one hand, returns false once the context is cancelled
+func (t *progressTracker) step() bool {
+	t.pending++
+	if t.pending < progressCheckInterval {
+		return true
+	}
+	return t.add(0)
+}
+
+// counts a number of hands, returns false once the context is cancelled
+func (t *progressTracker) add(hands uint64) bool {
+	t.progress.Processed += t.pending + hands
+	t.pending = 0
+
+	if t.report != nil {
+		if now := time.Now(); now.Sub(t.lastReport) >= progressReportInterval {
+			t.lastReport = now
+			t.send(now)
+		}
+	}
+
+	return t.ctx.Err() == nil
+}
+
+// reports the final progress
+func (t *progressTracker) done() {
+	t.progress.Processed += t.pending
+	t.pending = 0
+	if t.report != nil {
+		t.send(time.Now())
+	}
+}
+
+// returns a progress function that adds the progress of a nested computation to this one
+func (t *progressTracker) forward() func(Progress) {
+	processed := uint64(0)
+	return func(p Progress) {
+		t.add(p.Processed - processed)
+		processed = p.Processed
+	}
+}
+
+func (t *progressTracker) send(now time.Time) {
+	t.progress.Elapsed = now.Sub(t.start)
+	t.progress.ETA = 0
+	if t.progress.Processed > 0 && t.progress.Processed < t.progress.Total {
+		remaining := float64(t.progress.Total-t.progress.Processed) / float64(t.progress.Processed)
+		t.progress.ETA = time.Duration(float64(t.progress.Elapsed) * remaining)
+	}
+	t.report(t.progress)
+}
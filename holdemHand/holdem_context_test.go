@@ -0,0 +1,136 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDeckHandsRangeContext(t *testing.T) {
+	shared, _ := ParseHand("As")
+	dead, _ := ParseHand("2c 3c 4c")
+
+	want := []uint64{}
+	DeckHandsRange(FullDeck, shared, dead, 4, func(mask uint64) {
+		want = append(want, mask)
+	})
+
+	got := []uint64{}
+	lastProgress := Progress{}
+	err := DeckHandsRangeContext(context.Background(), FullDeck, shared, dead, 4,
+		func(mask uint64) { got = append(got, mask) },
+		func(progress Progress) { lastProgress = progress })
+	if err != nil {
+		t.Fatalf("DeckHandsRangeContext() failed: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Incorrect number of hands. Want %d, Got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Hands out of order at %d", i)
+		}
+	}
+
+	if lastProgress.Processed != uint64(len(want)) || lastProgress.Total != uint64(len(want)) || lastProgress.ETA != 0 {
+		t.Fatalf("Incorrect progress %+v", lastProgress)
+	}
+}
+
+func TestHandsRangeContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	err := HandsRangeContext(ctx, 7, func(mask uint64) {
+		count++
+		if count == 1000 {
+			cancel()
+		}
+	}, nil)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expecting the enumeration to be cancelled, got %v", err)
+	}
+	if count > 1000+progressCheckInterval {
+		t.Fatalf("Enumeration went on for %d hands after being cancelled", count-1000)
+	}
+}
+
+func TestRangeEquityContext(t *testing.T) {
+	aces, _ := ParseHand("As Ah")
+	kings, _ := ParseHand("Ks Kh")
+	flop, _ := ParseHand("2c 7d 9h")
+
+	want, err := HandEquity([]uint64{aces, kings}, flop, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastProgress := Progress{}
+	got, err := HandEquityContext(context.Background(), []uint64{aces, kings}, flop, 0,
+		func(progress Progress) { lastProgress = progress })
+	if err != nil {
+		t.Fatalf("HandEquityContext() failed: %v", err)
+	}
+
+	if got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("Incorrect equity. Want %+v, Got %+v", want, got)
+	}
+	if lastProgress.Processed != 990 || lastProgress.Total != 990 {
+		t.Fatalf("Incorrect progress %+v", lastProgress)
+	}
+
+	// preflop, played out in parallel
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = RangeEquityContext(ctx, [][]uint64{{aces}, {kings}}, 0, 0, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expecting the equity calculation to be cancelled, got %v", err)
+	}
+}
+
+func TestAnalyzeDrawContext(t *testing.T) {
+	hand, _ := ParseHand("As Ks Qs Js 2c")
+
+	lastProgress := Progress{}
+	options, err := AnalyzeDrawContext(context.Background(), hand, 0, 0,
+		func(progress Progress) { lastProgress = progress })
+	if err != nil {
+		t.Fatalf("AnalyzeDrawContext() failed: %v", err)
+	}
+
+	draws := uint64(0)
+	for _, option := range options {
+		draws += option.Draws
+	}
+	if lastProgress.Processed != draws || lastProgress.Total != draws {
+		t.Fatalf("Incorrect progress %+v, expecting %d draws", lastProgress, draws)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := AnalyzeDrawContext(ctx, hand, 0, 0, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expecting the analysis to be cancelled, got %v", err)
+	}
+}
+
+func TestPineappleDiscardContext(t *testing.T) {
+	hole, _ := ParseHand("As Ah 7c")
+	flop, _ := ParseHand("Kd 8h 2s")
+	opponent, _ := ParseHand("Kc Qc")
+
+	lastProgress := Progress{}
+	options, err := PineappleDiscardContext(context.Background(), hole, flop, [][]uint64{{opponent}},
+		func(progress Progress) { lastProgress = progress })
+	if err != nil {
+		t.Fatalf("PineappleDiscardContext() failed: %v", err)
+	}
+
+	boards := uint64(0)
+	for _, option := range options {
+		boards += option.Result.Total
+	}
+	if lastProgress.Processed != boards || lastProgress.Total != boards {
+		t.Fatalf("Incorrect progress %+v, expecting %d boards", lastProgress, boards)
+	}
+}
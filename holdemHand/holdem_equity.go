@@ -0,0 +1,276 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+)
+
+// The outcome of an equity calculation for a single player
+type EquityResult struct {
+	Wins   uint64
+	Ties   uint64
+	Losses uint64
+	// number of boards (and opponent hand combinations) evaluated
+	Total uint64
+	// pots won plus the share of split pots, divided by Total
+	Equity float64
+}
+
+// Calculates the all-in equity of each pocket by enumerating every possible
+// board that completes the given board. Each pocket is a two card mask.
+// Dead cards are removed from the deck.
+func HandEquity(pockets []uint64, board uint64, dead uint64) ([]EquityResult, error) {
+	return GameHandEquity(Holdem, pockets, board, dead)
+}
+
+// Calculates the all-in equity of each player given a range of possible pockets
+// for every player. Every non conflicting combination of pockets is played out
+// against every possible board, each combination having the same weight.
+// A player with a known hand has a range of one pocket.
+func RangeEquity(ranges [][]uint64, board uint64, dead uint64) ([]EquityResult, error) {
+	return GameRangeEquity(Holdem, ranges, board, dead)
+}
+
+// Same as HandEquity() for any game
+func GameHandEquity(game Game, pockets []uint64, board uint64, dead uint64) ([]EquityResult, error) {
+	ranges := make([][]uint64, len(pockets))
+	for i, pocket := range pockets {
+		ranges[i] = []uint64{pocket}
+	}
+	return GameRangeEquity(game, ranges, board, dead)
+}
+
+// Same as RangeEquity() for any game. In split pot games half of each pot goes to
+// the best high hand and half to the best low hand, if any hand qualifies for low.
+func GameRangeEquity(game Game, ranges [][]uint64, board uint64, dead uint64) ([]EquityResult, error) {
+	return GameRangeEquityContext(context.Background(), game, ranges, board, dead, nil)
+}
+
+// Same as HandEquity() but stops early when the context is cancelled, returning the
+// context's error. progress, which can be nil, is called every so often with the
+// number of boards played out so far.
+func HandEquityContext(ctx context.Context, pockets []uint64, board uint64, dead uint64,
+	progress func(Progress)) ([]EquityResult, error) {
+	return GameHandEquityContext(ctx, Holdem, pockets, board, dead, progress)
+}
+
+// Same as RangeEquity() but stops early when the context is cancelled, see HandEquityContext().
+func RangeEquityContext(ctx context.Context, ranges [][]uint64, board uint64, dead uint64,
+	progress func(Progress)) ([]EquityResult, error) {
+	return GameRangeEquityContext(ctx, Holdem, ranges, board, dead, progress)
+}
+
+// Same as GameHandEquity() but stops early when the context is cancelled, see HandEquityContext().
+func GameHandEquityContext(ctx context.Context, game Game, pockets []uint64, board uint64, dead uint64,
+	progress func(Progress)) ([]EquityResult, error) {
+	ranges := make([][]uint64, len(pockets))
+	for i, pocket := range pockets {
+		ranges[i] = []uint64{pocket}
+	}
+	return GameRangeEquityContext(ctx, game, ranges, board, dead, progress)
+}
+
+// Same as GameRangeEquity() but stops early when the context is cancelled, see HandEquityContext().
+func GameRangeEquityContext(ctx context.Context, game Game, ranges [][]uint64, board uint64, dead uint64,
+	progress func(Progress)) ([]EquityResult, error) {
+	boards, err := equityBoards(game, ranges, board, dead)
+	if err != nil {
+		return nil, err
+	}
+
+	deck := game.Deck()
+	boardSize := GameBoardSize(game)
+	tracker := newProgressTracker(ctx, progress, boards)
+	total := newEquityAccumulator(game, len(ranges))
+	pockets := make([]uint64, len(ranges))
+	needed := boardSize - bits.OnesCount64(board)
+
+	forEachPocketCombination(ranges, board|dead, pockets, func(used uint64) bool {
+		// share big enumerations out to all the cores
+		if Binomial(bits.OnesCount64(deck&^used), needed) < parallelEquityBoards {
+			handsRangeCardsUntil(deckCards(deck&^used), board, needed, func(mask uint64) bool {
+				total.add(pockets, mask)
+				return tracker.step()
+			})
+			return ctx.Err() == nil
+		}
+
+		var acc *equityAccumulator
+		acc, err = DeckHandsRangeParallel(ctx, deck, board, used&^board, boardSize,
+			ParallelOptions{Progress: tracker.forward()},
+			func() *equityAccumulator { return newEquityAccumulator(game, len(pockets)) },
+			func(acc *equityAccumulator, mask uint64) { acc.add(pockets, mask) },
+			(*equityAccumulator).merge)
+		if err != nil {
+			return false
+		}
+		total.merge(acc)
+		return true
+	})
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err == nil {
+		err = total.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	tracker.done()
+
+	if total.results[0].Total == 0 {
+		return nil, errors.New("No possible combination of hands")
+	}
+
+	for i := range total.results {
+		total.results[i].Equity = total.shares[i] / float64(total.results[i].Total)
+	}
+
+	return total.results, nil
+}
+
+// checks the arguments of an equity calculation and returns the number of boards it will play out
+func equityBoards(game Game, ranges [][]uint64, board uint64, dead uint64) (uint64, error) {
+	if len(ranges) < 2 {
+		return 0, errors.New("At least two players are required")
+	}
+
+	deck := game.Deck()
+	boardSize := GameBoardSize(game)
+	if bits.OnesCount64(board) > boardSize || board&dead != 0 || board&^deck != 0 {
+		return 0, errors.New("Bad board definition")
+	}
+
+	for _, pockets := range ranges {
+		if len(pockets) == 0 {
+			return 0, errors.New("Empty range")
+		}
+		for _, pocket := range pockets {
+			if bits.OnesCount64(pocket) != game.PocketCards() || pocket&^deck != 0 {
+				return 0, ErrBadHand
+			}
+		}
+	}
+
+	boards := uint64(0)
+	forEachPocketCombination(ranges, board|dead, make([]uint64, len(ranges)), func(used uint64) bool {
+		boards += Binomial(bits.OnesCount64(deck&^used), boardSize-bits.OnesCount64(board))
+		return true
+	})
+	return boards, nil
+}
+
+// calls callback with every non conflicting combination of pockets from the ranges,
+// filling in pockets and passing the mask of all the cards used. Stops as soon as
+// the callback returns false.
+func forEachPocketCombination(ranges [][]uint64, used uint64, pockets []uint64, callback func(used uint64) bool) bool {
+	player := len(ranges) - len(pockets)
+	if len(pockets) == 0 {
+		return callback(used)
+	}
+
+	for _, pocket := range ranges[player] {
+		if pocket&used == 0 {
+			pockets[0] = pocket
+			if !forEachPocketCombination(ranges, used|pocket, pockets[1:], callback) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// boards per combination of pockets above which equity is worked out in parallel
+const parallelEquityBoards = 1 << 16
+
+// the running totals of an equity calculation
+type equityAccumulator struct {
+	game      Game
+	selection HandSelection
+	results   []EquityResult
+	shares    []float64
+	highs     []uint
+	lows      []uint
+	err       error
+}
+
+func newEquityAccumulator(game Game, players int) *equityAccumulator {
+	return &equityAccumulator{
+		game:      game,
+		selection: game.HandSelection(),
+		results:   make([]EquityResult, players),
+		shares:    make([]float64, players),
+		highs:     make([]uint, players),
+		lows:      make([]uint, players),
+	}
+}
+
+// plays out the pockets on a complete board
+func (acc *equityAccumulator) add(pockets []uint64, board uint64) {
+	bestHigh, highWinners := uint(0), 0
+	bestLow, lowWinners := uint(0), 0
+	for i, pocket := range pockets {
+		var err error
+		acc.highs[i], err = bestHand(acc.game, acc.selection, pocket, board)
+		if err != nil {
+			acc.err = err
+		}
+		if acc.highs[i] > bestHigh || highWinners == 0 {
+			bestHigh, highWinners = acc.highs[i], 1
+		} else if acc.highs[i] == bestHigh {
+			highWinners++
+		}
+
+		low, ok := acc.game.EvaluateLow(pocket, board)
+		acc.lows[i] = 0
+		if ok {
+			// keep zero free for hands that don't qualify
+			acc.lows[i] = low + 1
+			if acc.lows[i] > bestLow {
+				bestLow, lowWinners = acc.lows[i], 1
+			} else if acc.lows[i] == bestLow {
+				lowWinners++
+			}
+		}
+	}
+
+	highPot := 1.0
+	if lowWinners > 0 {
+		highPot = 0.5
+	}
+
+	for i := range pockets {
+		share := 0.0
+		if acc.highs[i] == bestHigh {
+			share += highPot / float64(highWinners)
+		}
+		if lowWinners > 0 && acc.lows[i] == bestLow {
+			share += (1 - highPot) / float64(lowWinners)
+		}
+
+		acc.results[i].Total++
+		acc.shares[i] += share
+		if share == 0 {
+			acc.results[i].Losses++
+		} else if share == 1 {
+			acc.results[i].Wins++
+		} else {
+			acc.results[i].Ties++
+		}
+	}
+}
+
+func (acc *equityAccumulator) merge(other *equityAccumulator) {
+	for i := range acc.results {
+		acc.results[i].Wins += other.results[i].Wins
+		acc.results[i].Ties += other.results[i].Ties
+		acc.results[i].Losses += other.results[i].Losses
+		acc.results[i].Total += other.results[i].Total
+		acc.shares[i] += other.shares[i]
+	}
+	if acc.err == nil {
+		acc.err = other.err
+	}
+}
@@ -0,0 +1,310 @@
+package holdemHand
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math/bits"
+	"sort"
+	"sync"
+)
+
+// A cache of heads-up hold'em equities. Matchups are stored under a canonical key,
+// the smallest of the 24 ways of relabelling the suits of the two pockets and the
+// board either way round, so As Ah vs Ks Kh, Ad Ac vs Kd Kc and Kd Kc vs Ad Ac
+// share an entry. Matchups that aren't in the cache are worked out with HandEquity()
+// and added to it. The cache is safe to use from several goroutines.
+type EquityCache struct {
+	lock    sync.RWMutex
+	entries map[equityCacheKey]equityCacheEntry
+}
+
+type equityCacheKey struct {
+	pocket1 uint64
+	pocket2 uint64
+	board   uint64
+}
+
+// the outcomes for the first pocket
+type equityCacheEntry struct {
+	wins   uint32
+	ties   uint32
+	losses uint32
+}
+
+const (
+	equityCacheFileMagic   uint32 = 0x43514548 // HEQC
+	equityCacheFileVersion uint32 = 1
+)
+
+// every way to relabel the four suits
+var suitPermutations = func() (permutations [24][4]uint) {
+	i := 0
+	var permute func(suits [4]uint, n int)
+	permute = func(suits [4]uint, n int) {
+		if n == len(suits) {
+			permutations[i] = suits
+			i++
+			return
+		}
+		for a := n; a < len(suits); a++ {
+			suits[n], suits[a] = suits[a], suits[n]
+			permute(suits, n+1)
+			suits[n], suits[a] = suits[a], suits[n]
+		}
+	}
+	permute([4]uint{0, 1, 2, 3}, 0)
+	return permutations
+}()
+
+func NewEquityCache() *EquityCache {
+	return &EquityCache{entries: map[equityCacheKey]equityCacheEntry{}}
+}
+
+// Reads a cache written by WriteTo().
+func LoadEquityCache(r io.Reader) (*EquityCache, error) {
+	hash := crc32.NewIEEE()
+	reader := io.TeeReader(bufio.NewReader(r), hash)
+
+	var header [3]uint32
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header[0] != equityCacheFileMagic || header[1] != equityCacheFileVersion {
+		return nil, errors.New("Not an equity cache file")
+	}
+
+	c := NewEquityCache()
+	var record struct {
+		Pocket1, Pocket2, Board uint64
+		Wins, Ties, Losses      uint32
+	}
+	for i := uint32(0); i < header[2]; i++ {
+		if err := binary.Read(reader, binary.LittleEndian, &record); err != nil {
+			return nil, err
+		}
+		c.entries[equityCacheKey{record.Pocket1, record.Pocket2, record.Board}] =
+			equityCacheEntry{record.Wins, record.Ties, record.Losses}
+	}
+
+	sum := hash.Sum32()
+	var checksum uint32
+	if err := binary.Read(reader, binary.LittleEndian, &checksum); err != nil {
+		return nil, err
+	}
+	if checksum != sum || len(c.entries) != int(header[2]) {
+		return nil, errors.New("Equity cache file is corrupt")
+	}
+
+	return c, nil
+}
+
+// Writes the cache so it can be loaded with LoadEquityCache(). The entries are
+// written in key order so the same cache always gives the same file.
+func (c *EquityCache) WriteTo(w io.Writer) (int64, error) {
+	c.lock.RLock()
+	keys := make([]equityCacheKey, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	c.lock.RUnlock()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+
+	hash := crc32.NewIEEE()
+	writer := bufio.NewWriter(w)
+	counter := &countingWriter{w: io.MultiWriter(writer, hash)}
+
+	header := [3]uint32{equityCacheFileMagic, equityCacheFileVersion, uint32(len(keys))}
+	if err := binary.Write(counter, binary.LittleEndian, header); err != nil {
+		return counter.n, err
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, key := range keys {
+		entry := c.entries[key]
+		record := [3]uint64{key.pocket1, key.pocket2, key.board}
+		if err := binary.Write(counter, binary.LittleEndian, record); err != nil {
+			return counter.n, err
+		}
+		outcomes := [3]uint32{entry.wins, entry.ties, entry.losses}
+		if err := binary.Write(counter, binary.LittleEndian, outcomes); err != nil {
+			return counter.n, err
+		}
+	}
+
+	if err := binary.Write(counter, binary.LittleEndian, hash.Sum32()); err != nil {
+		return counter.n, err
+	}
+	return counter.n, writer.Flush()
+}
+
+// The number of matchups (pockets and board) in the cache
+func (c *EquityCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.entries)
+}
+
+// Returns the same results as HandEquity() for two pockets and no dead cards,
+// from the cache when the matchup (or one with the suits relabelled or the
+// players swapped) is in it.
+func (c *EquityCache) HandEquity(pocket1 uint64, pocket2 uint64, board uint64) ([]EquityResult, error) {
+	key, swapped := canonicalMatchupKey(pocket1, pocket2, board)
+
+	c.lock.RLock()
+	entry, ok := c.entries[key]
+	c.lock.RUnlock()
+
+	if !ok {
+		results, err := HandEquity([]uint64{pocket1, pocket2}, board, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		entry = newEquityCacheEntry(results[0], swapped)
+		c.add(key, entry)
+	}
+
+	results := entry.results()
+	if swapped {
+		results[0], results[1] = results[1], results[0]
+	}
+	return results, nil
+}
+
+// Adds every board with the given number of cards (0, 3, 4 or 5) to the cache for
+// a matchup, so the cache can be generated offline. Boards that are the same once
+// the suits are relabelled are only worked out once. progress, which can be nil,
+// is called every so often with the number of boards done so far.
+func (c *EquityCache) Generate(ctx context.Context, pocket1 uint64, pocket2 uint64, boardCards int,
+	progress func(Progress)) error {
+	if bits.OnesCount64(pocket1) != 2 || bits.OnesCount64(pocket2) != 2 || pocket1&pocket2 != 0 ||
+		(pocket1|pocket2)&^FullDeck != 0 {
+		return ErrBadHand
+	}
+	if boardCards != 0 && (boardCards < 3 || boardCards > 5) {
+		return fmt.Errorf("%w: a board has 3 to 5 cards, not %d", ErrBadHand, boardCards)
+	}
+
+	type generated map[equityCacheKey]equityCacheEntry
+	entries, err := DeckHandsRangeParallel(ctx, FullDeck, 0, pocket1|pocket2, boardCards,
+		ParallelOptions{Progress: progress},
+		func() generated { return generated{} },
+		func(entries generated, board uint64) {
+			key, swapped := canonicalMatchupKey(pocket1, pocket2, board)
+			if _, ok := entries[key]; ok || c.contains(key) {
+				return
+			}
+
+			results, err := HandEquityContext(ctx, []uint64{pocket1, pocket2}, board, 0, nil)
+			if err == nil {
+				entries[key] = newEquityCacheEntry(results[0], swapped)
+			}
+		},
+		func(dst generated, src generated) {
+			for key, entry := range src {
+				dst[key] = entry
+			}
+		})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key, entry := range entries {
+		c.entries[key] = entry
+	}
+	return nil
+}
+
+func (c *EquityCache) add(key equityCacheKey, entry equityCacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = entry
+}
+
+func (c *EquityCache) contains(key equityCacheKey) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	_, ok := c.entries[key]
+	return ok
+}
+
+// the key of the matchup with its suits relabelled to give the smallest masks
+func canonicalEquityKey(pocket1 uint64, pocket2 uint64, board uint64) equityCacheKey {
+	best := equityCacheKey{pocket1, pocket2, board}
+	for _, permutation := range suitPermutations[1:] {
+		key := equityCacheKey{
+			permuteSuits(pocket1, permutation),
+			permuteSuits(pocket2, permutation),
+			permuteSuits(board, permutation),
+		}
+		if key.less(best) {
+			best = key
+		}
+	}
+	return best
+}
+
+// the smallest key of the matchup either way round, swapped is true when the
+// second pocket comes first in the key
+func canonicalMatchupKey(pocket1 uint64, pocket2 uint64, board uint64) (key equityCacheKey, swapped bool) {
+	key = canonicalEquityKey(pocket1, pocket2, board)
+	if other := canonicalEquityKey(pocket2, pocket1, board); other.less(key) {
+		return other, true
+	}
+	return key, false
+}
+
+// moves the cards of each suit to the suit given by the permutation
+func permuteSuits(mask uint64, permutation [4]uint) uint64 {
+	result := uint64(0)
+	for suit, to := range permutation {
+		result |= ((mask >> (13 * uint(suit))) & 0x1FFF) << (13 * to)
+	}
+	return result
+}
+
+func (key equityCacheKey) less(other equityCacheKey) bool {
+	if key.pocket1 != other.pocket1 {
+		return key.pocket1 < other.pocket1
+	}
+	if key.pocket2 != other.pocket2 {
+		return key.pocket2 < other.pocket2
+	}
+	return key.board < other.board
+}
+
+// the entry for the results of the first pocket, or of the second when swapped
+func newEquityCacheEntry(result EquityResult, swapped bool) equityCacheEntry {
+	if swapped {
+		return equityCacheEntry{uint32(result.Losses), uint32(result.Ties), uint32(result.Wins)}
+	}
+	return equityCacheEntry{uint32(result.Wins), uint32(result.Ties), uint32(result.Losses)}
+}
+
+func (entry equityCacheEntry) results() []EquityResult {
+	total := uint64(entry.wins) + uint64(entry.ties) + uint64(entry.losses)
+	return []EquityResult{
+		{
+			Wins:   uint64(entry.wins),
+			Ties:   uint64(entry.ties),
+			Losses: uint64(entry.losses),
+			Total:  total,
+			Equity: (float64(entry.wins) + float64(entry.ties)/2) / float64(total),
+		},
+		{
+			Wins:   uint64(entry.losses),
+			Ties:   uint64(entry.ties),
+			Losses: uint64(entry.wins),
+			Total:  total,
+			Equity: (float64(entry.losses) + float64(entry.ties)/2) / float64(total),
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package holdemHand
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestEquityCache(t *testing.T) {
+	cache := NewEquityCache()
+
+	aces, _ := ParseHand("As Ah")
+	kings, _ := ParseHand("Ks Kh")
+	flop, _ := ParseHand("2s 7d 9h")
+	want, err := HandEquity([]uint64{aces, kings}, flop, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := cache.HandEquity(aces, kings, flop)
+	if err != nil {
+		t.Fatalf("HandEquity() failed: %v", err)
+	}
+	if got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("Incorrect equity. Want %+v, Got %+v", want, got)
+	}
+
+	// the same matchup with the suits relabelled is found in the cache
+	otherAces, _ := ParseHand("Ad Ac")
+	otherKings, _ := ParseHand("Kd Kc")
+	otherFlop, _ := ParseHand("2d 7h 9c")
+	got, err = cache.HandEquity(otherAces, otherKings, otherFlop)
+	if err != nil {
+		t.Fatalf("HandEquity() failed: %v", err)
+	}
+	if got[0] != want[0] || got[1] != want[1] || cache.Len() != 1 {
+		t.Fatalf("Expecting a cache hit with %+v, got %+v and %d entries", want, got, cache.Len())
+	}
+
+	if _, err := cache.HandEquity(aces, aces, flop); err == nil {
+		t.Fatal("Expecting an error for conflicting pockets")
+	}
+}
+
+func TestEquityCacheGenerate(t *testing.T) {
+	cache := NewEquityCache()
+	aces, _ := ParseHand("As Ah")
+	suited, _ := ParseHand("Kc Qc")
+
+	if err := cache.Generate(context.Background(), aces, suited, 3, nil); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	generated := cache.Len()
+	if err := cache.Generate(context.Background(), aces, suited, 2, nil); !errors.Is(err, ErrBadHand) {
+		t.Fatalf("Expecting ErrBadHand for a two card board, got %v", err)
+	}
+	if generated == 0 || uint64(generated) >= Binomial(48, 3) {
+		t.Fatalf("Expecting fewer entries than boards, got %d", generated)
+	}
+
+	// every board is now a cache hit with the right equity
+	count := 0
+	DeckHandsRange(FullDeck, 0, aces|suited, 3, func(board uint64) {
+		if count++; count%97 != 0 {
+			return
+		}
+		want, _ := HandEquity([]uint64{aces, suited}, board, 0)
+		got, _ := cache.HandEquity(aces, suited, board)
+		if got[0] != want[0] {
+			t.Fatalf("Incorrect equity on %s. Want %+v, Got %+v", MaskToString(board), want[0], got[0])
+		}
+	})
+	if cache.Len() != generated {
+		t.Fatalf("Expecting only cache hits, the cache grew from %d to %d", generated, cache.Len())
+	}
+
+	var file bytes.Buffer
+	if _, err := cache.WriteTo(&file); err != nil {
+		t.Fatalf("WriteTo() failed: %v", err)
+	}
+	data := bytes.Clone(file.Bytes())
+
+	loaded, err := LoadEquityCache(&file)
+	if err != nil {
+		t.Fatalf("LoadEquityCache() failed: %v", err)
+	}
+	if loaded.Len() != generated {
+		t.Fatalf("Incorrect number of entries loaded. Want %d, Got %d", generated, loaded.Len())
+	}
+
+	var again bytes.Buffer
+	loaded.WriteTo(&again)
+	if !bytes.Equal(again.Bytes(), data) {
+		t.Fatal("Writing a loaded cache gives a different file")
+	}
+
+	data[len(data)/2] ^= 1
+	if _, err := LoadEquityCache(bytes.NewReader(data)); err == nil {
+		t.Fatal("Expecting an error loading a corrupt file")
+	}
+}
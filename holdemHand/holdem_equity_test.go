@@ -0,0 +1,80 @@
+package holdemHand
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHandsRangeShared(t *testing.T) {
+	shared, _ := ParseHand("As Ks")
+	dead, _ := ParseHand("2c 2d")
+	count := 0
+	HandsRangeShared(shared, dead, 5, func(mask uint64) {
+		if mask&shared != shared || mask&dead != 0 || bitCount(mask) != 5 {
+			t.Fatalf("Unexpected hand %s", MaskToString(mask))
+		}
+		count++
+	})
+
+	// choose 3 from the 48 remaining cards
+	if count != 17296 {
+		t.Fatalf("Incorrect number of hands. Want 17296, Got %d", count)
+	}
+}
+
+func TestHandEquity(t *testing.T) {
+	aces, _ := ParseHand("As Ah")
+	kings, _ := ParseHand("Kd Kc")
+	results, err := HandEquity([]uint64{aces, kings}, 0, 0)
+	if err != nil {
+		t.Fatalf("HandEquity() failed: %v", err)
+	}
+
+	if results[0].Total != 1712304 {
+		t.Fatalf("Incorrect number of boards. Want 1712304, Got %d", results[0].Total)
+	}
+
+	if results[0].Wins != 1388072 || results[0].Ties != 6538 || results[0].Losses != 317694 {
+		t.Fatalf("Incorrect results for aces versus kings. Got %+v", results[0])
+	}
+
+	if math.Abs(results[0].Equity-0.812555) > 1e-6 {
+		t.Fatalf("Incorrect equity for aces versus kings. Got %f", results[0].Equity)
+	}
+
+	if math.Abs(results[0].Equity+results[1].Equity-1) > 1e-9 {
+		t.Fatalf("Equities should add up to 1")
+	}
+
+	board, _ := ParseHand("Ac 2s 3h 4d 5c")
+	results, _ = HandEquity([]uint64{aces, kings}, board, 0)
+	if results[0].Ties != 1 || results[0].Equity != 0.5 {
+		t.Fatalf("Expecting a split pot on a wheel board")
+	}
+}
+
+func TestRangeEquity(t *testing.T) {
+	hero, _ := ParseHand("As Ah")
+	kings, _ := ParseHand("Kd Kc")
+	queens, _ := ParseHand("Qd Qc")
+	board, _ := ParseHand("Ks Qs 2c 7h")
+
+	results, err := RangeEquity([][]uint64{{hero}, {kings, queens}}, board, 0)
+	if err != nil {
+		t.Fatalf("RangeEquity() failed: %v", err)
+	}
+
+	// two opponent hands with 44 rivers each
+	if results[0].Total != 88 {
+		t.Fatalf("Incorrect number of outcomes. Want 88, Got %d", results[0].Total)
+	}
+
+	// aces lose to a set on every river but an ace
+	if results[0].Wins != 2*2 {
+		t.Fatalf("Incorrect number of wins. Want 4, Got %d", results[0].Wins)
+	}
+
+	if _, err := HandEquity([]uint64{hero, hero}, 0, 0); err == nil {
+		t.Fatalf("Expecting an error when both players hold the same cards")
+	}
+}
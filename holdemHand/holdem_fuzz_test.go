@@ -0,0 +1,138 @@
+package holdemHand
+
+import (
+	"strings"
+	"testing"
+)
+
+// Run these with go test -fuzz FuzzParseHand and so on, the seeds and the
+// corpus in testdata/fuzz run as part of the normal tests.
+
+var fuzzHandSeeds = []string{
+	"", " ", "1", "10", "10h", "10h 9c", "As", "as ks", "AsKs", "As Ks Qs Js Ts", "2c 3d 4h 5s 6c 7d 8h",
+	"As As", "Xx", "xx", "Jk", "Jk As", "Xx As Ks", "Ax", "X", "Zz", "As ", " As", "As\tKs", "AsKsQsJsTs9s8s7s",
+}
+
+func FuzzParseHand(f *testing.F) {
+	for _, seed := range fuzzHandSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, hand string) {
+		mask, err := ParseHand(hand)
+		if err != nil {
+			if ValidateHand(hand) {
+				t.Fatalf("ParseHand(%q) failed but ValidateHand() accepts it: %v", hand, err)
+			}
+			return
+		}
+
+		if mask == 0 {
+			if strings.Trim(hand, " ") != "" {
+				t.Fatalf("ParseHand(%q) returned no cards", hand)
+			}
+			return
+		}
+
+		if mask>>(CardJoker+1) != 0 {
+			t.Fatalf("ParseHand(%q) = %#x has bits above the joker", hand, mask)
+		}
+
+		again, err := ParseHand(MaskToString(mask))
+		if err != nil || again != mask {
+			t.Fatalf("%q parses to %#x, but %q parses to %#x, %v", hand, mask, MaskToString(mask), again, err)
+		}
+	})
+}
+
+func FuzzValidateHand(f *testing.F) {
+	for _, seed := range fuzzHandSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, hand string) {
+		valid := ValidateHand(hand)
+		mask, err := ParseHand(hand)
+		if valid != (err == nil && mask != 0) {
+			t.Fatalf("ValidateHand(%q) = %v but ParseHand() = %#x, %v", hand, valid, mask, err)
+		}
+	})
+}
+
+func FuzzParseCard(f *testing.F) {
+	for _, seed := range fuzzHandSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		card := ParseCard(text)
+		if card < -2 || card > CardJoker {
+			t.Fatalf("ParseCard(%q) = %d is out of range", text, card)
+		}
+
+		if card >= 0 {
+			if again := ParseCard(MaskToString(uint64(1) << card)); again != card {
+				t.Fatalf("ParseCard(%q) = %d, but the same card prints as %q", text, card, MaskToString(uint64(1)<<card))
+			}
+		}
+	})
+}
+
+func FuzzEvaluateHandText(f *testing.F) {
+	for _, seed := range fuzzHandSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, hand string) {
+		value, err := EvaluateHandText(hand)
+		if err != nil {
+			return
+		}
+
+		mask, _ := ParseHand(hand)
+		if want, _ := EvaluateMask(mask); value != want {
+			t.Fatalf("EvaluateHandText(%q) = %#x, EvaluateMask() = %#x", hand, value, want)
+		}
+
+		if handType := int(getHandType(value)); handType != EvaluateType(mask) || handType > StraightFlush {
+			t.Fatalf("EvaluateHandText(%q) = %#x has the wrong hand type", hand, value)
+		}
+
+		HandDescriptionFromHandType(value)
+	})
+}
+
+func FuzzMaskToString(f *testing.F) {
+	for _, seed := range []uint64{0, 1, FullDeck, JokerMask, JokerMask | 1, 0x8000000000000001} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, mask uint64) {
+		mask &= FullDeck | JokerMask
+
+		text := MaskToString(mask)
+		again, err := ParseHand(text)
+		if err != nil || again != mask {
+			t.Fatalf("MaskToString(%#x) = %q parses to %#x, %v", mask, text, again, err)
+		}
+	})
+}
+
+func FuzzParseOptions(f *testing.F) {
+	for _, seed := range append(fuzzHandSeeds, "A♠K♥", "♠A ♥K", "10h,9c", "A♠️", "♠", "s", "1") {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, hand string) {
+		mask, err := AnyNotation.Parse(hand)
+		if err != nil {
+			return
+		}
+
+		formatter := CardFormatter{Unicode: true, SuitFirst: true, Ten: true, Separator: ","}
+		again, err := AnyNotation.Parse(formatter.Format(mask))
+		if err != nil || again != mask {
+			t.Fatalf("%q parses to %#x, but %q parses to %#x, %v", hand, mask, formatter.Format(mask), again, err)
+		}
+	})
+}
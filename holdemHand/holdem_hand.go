@@ -6,6 +6,12 @@ import (
 	"strings"
 )
 
+var (
+	ErrInvalidCardCount = errors.New("Invalid number of cards")
+	ErrBadHand          = errors.New("Bad hand definition")
+	ErrInvalidCard      = errors.New("Invalid card")
+)
+
 // This function takes a string representing a full or partial holdem mask
 // and validates that the text represents valid cards and that no card is duplicated.
 // Valid hand: 7c 2d. This is ok too: 2c 3d 4s 5c
@@ -50,7 +56,8 @@ func ParseHandWithBoard(pocket string, board string) (uint64, error) {
 	return parseHand(pocket+board, &cards)
 }
 
-// Get card value of given card string
+// Get card value of given card string, -1 when the string is empty
+// and -2 when it doesn't start with a card
 func ParseCard(card string) int {
 	cards := 0
 	return nextCard(card, &cards)
@@ -58,8 +65,8 @@ func ParseCard(card string) int {
 
 // given a card value, return the card rank
 func CardRank(card int) (int, error) {
-	if card < 0 && card > 52 {
-		return -1, errors.New("Invalid card. There are only 52 cards in a deck.")
+	if card < 0 || card > 52 {
+		return -1, ErrInvalidCard
 	}
 
 	return card % 13, nil
@@ -68,7 +75,7 @@ func CardRank(card int) (int, error) {
 // given a card value, return the card suit
 func CardSuit(card int) (int, error) {
 	if card < 0 || card > 52 {
-		return -1, errors.New("Invalid card")
+		return -1, ErrInvalidCard
 	}
 
 	return card / 13, nil
@@ -149,6 +156,13 @@ func HandDescriptionFromHandType(handValue uint) string {
 		sb.WriteString("A straight flush")
 		return sb.String()
 
+	case FiveOfAKind:
+		topCard := getTopCard(handValue)
+		sb.WriteString("Five of a kind, ")
+		sb.WriteString(RankTable[topCard])
+		sb.WriteString("'s")
+		return sb.String()
+
 	}
 
 	return ""
@@ -160,26 +174,29 @@ func HandDescriptionFromHandType(handValue uint) string {
 
 // Converts a hand mask to a hand text
 func MaskToString(mask uint64) string {
-	sb := strings.Builder{}
+	return string(AppendMaskString(make([]byte, 0, 3*(bitCount(mask)+1)), mask))
+}
 
+// Same as MaskToString() but appends the hand text to a caller supplied buffer and
+// returns the extended buffer. It doesn't allocate when the buffer has room.
+func AppendMaskString(dst []byte, mask uint64) []byte {
 	count := 0
+	if mask&JokerMask != 0 {
+		dst = append(dst, JokerText...)
+		count++
+	}
 
-	// for card := range cardsRange(mask) {
-	// 	if count > 0 {
-	// 		sb.WriteString(" ")
-	// 	}
-	// 	sb.WriteString(card)
-	// 	count++
-	// }
-	cardsRange2(mask, func(card string) {
-		if count > 0 {
-			sb.WriteString(" ")
+	for i := NumberOfCards - 1; i >= 0; i-- {
+		if (uint64(1)<<i)&mask != 0 {
+			if count > 0 {
+				dst = append(dst, ' ')
+			}
+			dst = append(dst, CardTable[i]...)
+			count++
 		}
-		sb.WriteString(card)
-		count++
-	})
+	}
 
-	return sb.String()
+	return dst
 }
 
 // This function is faster than Evaluate but provides less information
@@ -244,9 +261,25 @@ func EvaluateType(mask uint64) int {
 func EvaluateMask(mask uint64) (uint, error) {
 	numCards := bitCount(mask)
 	if numCards < 1 || numCards > 7 {
-		return 0, errors.New("Invalid number of cards")
+		return 0, ErrInvalidCardCount
 	}
 
+	// the joker needs EvaluateMaskWithJoker()
+	if mask>>NumberOfCards != 0 {
+		return 0, ErrInvalidCard
+	}
+
+	return evaluateMask(mask, numCards), nil
+}
+
+// Same as EvaluateMask() without checking the mask, for masks that are known to
+// hold one to seven cards. It never allocates.
+func EvaluateMaskUnchecked(mask uint64) uint {
+	return evaluateMask(mask, bitCount(mask))
+}
+
+// Does the work of EvaluateMask() for a mask with one to seven cards
+func evaluateMask(mask uint64, numCards uint) uint {
 	sc := uint((mask >> CLUB_OFFSET) & 0x1FFF)
 	sd := uint((mask >> DIAMOND_OFFSET) & 0x1FFF)
 	sh := uint((mask >> HEART_OFFSET) & 0x1FFF)
@@ -257,13 +290,6 @@ func EvaluateMask(mask uint64) (uint, error) {
 	numDups := numCards - uint(nRanks)
 	result := uint(0)
 
-	var straighOrFlushFunc func(uint) uint = func(suit uint) uint {
-		if StraightTable[suit] != 0 {
-			return HANDTYPE_VALUE_STRAIGHTFLUSH + uint(StraightTable[suit]<<uint16(TOP_CARD_SHIFT))
-		}
-		return HANDTYPE_VALUE_FLUSH + TopFiveCardsTable[suit]
-	}
-
 	// check for straight, flush or straight flush and return if we
 	// determine immediately that this is the best possible mask
 	if nRanks >= 5 {
@@ -273,7 +299,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 			// } else {
 			// 	result = HANDTYPE_VALUE_FLUSH + uint(TopFiveCardsTable[ss])
 			// }
-			result = straighOrFlushFunc(ss)
+			result = straightOrFlush(ss)
 
 		} else if BitsTable[sc] >= 5 {
 			// if StraightTable[sc] != 0 {
@@ -281,16 +307,16 @@ func EvaluateMask(mask uint64) (uint, error) {
 			// } else {
 			// 	result = HANDTYPE_VALUE_FLUSH + uint(TopFiveCardsTable[sc])
 			// }
-			result = straighOrFlushFunc(sc)
+			result = straightOrFlush(sc)
 		} else if BitsTable[sd] >= 5 {
 			// if StraightTable[sd] != 0 {
 			// 	return HANDTYPE_VALUE_STRAIGHTFLUSH + uint(StraightTable[sd]<<TOP_CARD_SHIFT), nil
 			// } else {
 			// 	result = HANDTYPE_VALUE_FLUSH + uint(TopFiveCardsTable[sd])
 			// }
-			result = straighOrFlushFunc(sd)
+			result = straightOrFlush(sd)
 		} else if BitsTable[sh] >= 5 {
-			result = straighOrFlushFunc(sh)
+			result = straightOrFlush(sh)
 		} else {
 			st := uint(StraightTable[ranks])
 			if st != 0 {
@@ -303,7 +329,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 		// found a five card mask, just return. This skips the whole process of
 		// computing two mask/three_mask/etc
 		if result != 0 && numDups < 3 {
-			return result, nil
+			return result
 		}
 	}
 
@@ -313,7 +339,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 	//	duplicates to make a full house / quads possible
 	switch numDups {
 	case 0:
-		return HANDTYPE_VALUE_HIGHCARD + TopFiveCardsTable[ranks], nil
+		return HANDTYPE_VALUE_HIGHCARD + TopFiveCardsTable[ranks]
 	case 1:
 		twoMask := ranks ^ (sc ^ sd ^ sh ^ ss)
 		result = HANDTYPE_VALUE_PAIR + TopCardTable[twoMask]<<TOP_CARD_SHIFT
@@ -323,7 +349,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 		// cards, and shift them by one to get the three desired kickers
 		kickers := (TopFiveCardsTable[t] >> CARD_WIDTH) &^ FIFTH_CARD_MASK
 		result += kickers
-		return result, nil
+		return result
 
 	case 2:
 		// either two pair or trips
@@ -331,17 +357,17 @@ func EvaluateMask(mask uint64) (uint, error) {
 		if twoMask != 0 {
 			t := ranks ^ twoMask // exactly two bits set in twoMask
 			result := HANDTYPE_VALUE_TWOPAIR + (TopFiveCardsTable[twoMask] & (TOP_CARD_MASK | SECOND_CARD_MASK)) + (TopCardTable[t] << THIRD_CARD_SHIFT)
-			return result, nil
+			return result
 		}
 
 		threeMask := ((sc & sd) | (sh & ss)) & ((sc & sh) | (sd & ss))
 		result := HANDTYPE_VALUE_TRIPS + TopCardTable[threeMask]<<TOP_CARD_SHIFT
-		t := ranks & threeMask // only one bit set in the threeMask
+		t := ranks ^ threeMask // only one bit set in the threeMask
 		second := TopCardTable[t]
 		result += second << SECOND_CARD_SHIFT
 		t ^= uint(1) << second
 		result += TopCardTable[t] << THIRD_CARD_SHIFT
-		return result, nil
+		return result
 
 	default:
 		// possible quads, full house or flush or two pair
@@ -349,7 +375,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 		if fourMask != 0 {
 			tc := TopCardTable[fourMask]
 			result := HANDTYPE_VALUE_FOUR_OF_A_KIND + (tc << TOP_CARD_SHIFT) + ((TopCardTable[ranks^uint(1)<<tc]) << SECOND_CARD_SHIFT)
-			return result, nil
+			return result
 		}
 
 		// technically, threeMask as defined below is really the set of bits
@@ -361,13 +387,13 @@ func EvaluateMask(mask uint64) (uint, error) {
 		if BitsTable[twoMask] != numDups {
 			// must be some trips then, which really means there is a
 			// full house since numDups >= 3
-			threeMask := ((sc ^ sd) | (sh & ss)) & ((sc & sh) | (sd & ss))
+			threeMask := ((sc & sd) | (sh & ss)) & ((sc & sh) | (sd & ss))
 			result := HANDTYPE_VALUE_FULLHOUSE
 			tc := TopCardTable[threeMask]
 			result += tc << TOP_CARD_SHIFT
 			t := (twoMask | threeMask) ^ (uint(1) << tc)
 			result += TopCardTable[t] << SECOND_CARD_SHIFT
-			return result, nil
+			return result
 		}
 
 		// must be two pair
@@ -377,7 +403,7 @@ func EvaluateMask(mask uint64) (uint, error) {
 		second := TopCardTable[twoMask^uint(1)<<top]
 		result += second << SECOND_CARD_SHIFT
 		result += TopCardTable[ranks^(uint(1)<<top)^(uint(1)<<second)] << THIRD_CARD_SHIFT
-		return result, nil
+		return result
 	}
 
 }
@@ -388,11 +414,19 @@ func EvaluateMask(mask uint64) (uint, error) {
 func EvaluateHandText(hand string) (uint, error) {
 	mask, e := ParseHand(hand)
 	if e != nil {
-		return 0, errors.New(e.Error())
+		return 0, e
 	}
 	return EvaluateMask(mask)
 }
 
+// hand value of a suit with five or more cards
+func straightOrFlush(suit uint) uint {
+	if StraightTable[suit] != 0 {
+		return HANDTYPE_VALUE_STRAIGHTFLUSH + StraightTable[suit]<<TOP_CARD_SHIFT
+	}
+	return HANDTYPE_VALUE_FLUSH + TopFiveCardsTable[suit]
+}
+
 func bitCount(mask uint64) uint {
 	x := uint64(0x1FFF)
 	ss := uint((mask >> SPADE_OFFSET) & x)
@@ -430,7 +464,7 @@ func parseHand(hand string, cards *int) (uint64, error) {
 	}
 
 	if !ValidateHand(hand) {
-		return 0, errors.New("Bad hand definition")
+		return 0, ErrBadHand
 	}
 
 	*cards = 0
@@ -462,12 +496,12 @@ func nextCard(cards string, index *int) int {
 
 	switch card {
 	case '1':
+		// ten written as 10
 		*index += 1
-		if cards[*index] == '0' {
-			rank = RankTen
-		} else {
-			return -1
+		if *index >= len(cards) || cards[*index] != '0' {
+			return -2
 		}
+		rank = RankTen
 
 	case '2':
 		rank = Rank2
@@ -495,6 +529,8 @@ func nextCard(cards string, index *int) int {
 		rank = RankKing
 	case 'A', 'a':
 		rank = RankAce
+	case 'X', 'x':
+		rank = CardJoker
 	default:
 		return -2
 	}
@@ -508,6 +544,16 @@ func nextCard(cards string, index *int) int {
 	suit := 0
 	card = cards[*index]
 
+	// a joker is written as Xx or Jk
+	if (rank == CardJoker && (card == 'X' || card == 'x')) ||
+		(rank == RankJack && (card == 'K' || card == 'k')) {
+		*index++
+		return CardJoker
+	}
+	if rank == CardJoker {
+		return -2
+	}
+
 	switch card {
 	case 'H', 'h':
 		suit = Hearts
@@ -0,0 +1,106 @@
+package holdemHand
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// Colex ranking of hand masks. Every N card hand has an index in [0, Binomial(52, N)),
+// its position when all N card masks are sorted as numbers. The index of a hand with
+// cards c1 < c2 < ... < cN is Binomial(c1, 1) + Binomial(c2, 2) + ... + Binomial(cN, N).
+// Indexes don't depend on the other cards in the deck, so a table of results
+// indexed this way can be shared out or resumed from any point.
+
+var ErrInvalidIndex = errors.New("Invalid hand index")
+
+// colexTable[card][i] is Binomial(card, i)
+var colexTable = func() (table [NumberOfCards][NumberOfCards + 1]uint64) {
+	for card := range table {
+		for i := range table[card] {
+			table[card][i] = Binomial(card, i)
+		}
+	}
+	return table
+}()
+
+// The number of ways to choose k items from n
+func Binomial(n int, k int) uint64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := uint64(1)
+	for i := 1; i <= k; i++ {
+		result = result * uint64(n-k+i) / uint64(i)
+	}
+	return result
+}
+
+// Returns the colex index of a hand mask among all the masks with the same number of cards.
+func MaskIndex(mask uint64) (uint64, error) {
+	if mask>>NumberOfCards != 0 {
+		return 0, ErrInvalidCard
+	}
+
+	index := uint64(0)
+	for i := 1; mask != 0; i++ {
+		index += colexTable[bits.TrailingZeros64(mask)][i]
+		mask &= mask - 1
+	}
+	return index, nil
+}
+
+// Returns the hand mask of numCards cards with the given colex index, the inverse of MaskIndex().
+func IndexMask(index uint64, numCards int) (uint64, error) {
+	if numCards < 0 || numCards > NumberOfCards {
+		return 0, ErrInvalidCardCount
+	}
+	if index >= Binomial(NumberOfCards, numCards) {
+		return 0, ErrInvalidIndex
+	}
+
+	// pick the highest card first, it's the largest card whose term fits in the index
+	mask := uint64(0)
+	card := NumberOfCards - 1
+	for i := numCards; i > 0; i-- {
+		for colexTable[card][i] > index {
+			card--
+		}
+		index -= colexTable[card][i]
+		mask |= CardMasksTable[card]
+		card--
+	}
+	return mask, nil
+}
+
+// Enumerates the numCards card hands with colex indexes in [start, end), in index order.
+// This visits the same hands as HandsRange2() in a different order, but any part of
+// the enumeration can be run on its own, e.g. to resume it or to share it between machines.
+func HandsRangeSlice(numCards int, start uint64, end uint64, callback func(mask uint64)) error {
+	end = min(end, Binomial(NumberOfCards, numCards))
+	if start >= end {
+		if numCards < 0 || numCards > NumberOfCards {
+			return ErrInvalidCardCount
+		}
+		return nil
+	}
+
+	mask, err := IndexMask(start, numCards)
+	if err != nil {
+		return err
+	}
+
+	for index := start; ; {
+		callback(mask)
+		if index++; index == end {
+			return nil
+		}
+		mask = nextColexMask(mask)
+	}
+}
+
+// the next largest mask with the same number of cards
+func nextColexMask(mask uint64) uint64 {
+	lowest := mask & -mask
+	ripple := mask + lowest
+	return ripple | (((mask ^ ripple) >> 2) / lowest)
+}
@@ -0,0 +1,83 @@
+package holdemHand
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaskIndex(t *testing.T) {
+	for numCards := 1; numCards <= 5; numCards++ {
+		total := Binomial(NumberOfCards, numCards)
+		seen := make([]bool, total)
+		position := uint64(0)
+
+		HandsRange2(numCards, func(mask uint64) {
+			index, err := MaskIndex(mask)
+			if err != nil {
+				t.Fatalf("MaskIndex(%s) failed: %v", MaskToString(mask), err)
+			}
+			if index >= total || seen[index] {
+				t.Fatalf("Index %d of %s is out of range or used twice", index, MaskToString(mask))
+			}
+			seen[index] = true
+
+			if back, _ := IndexMask(index, numCards); back != mask {
+				t.Fatalf("IndexMask(%d) = %s, expecting %s", index, MaskToString(back), MaskToString(mask))
+			}
+
+			position++
+		})
+
+		if position != total {
+			t.Fatalf("Incorrect number of %d card hands. Want %d, Got %d", numCards, total, position)
+		}
+	}
+}
+
+func TestIndexMaskErrors(t *testing.T) {
+	if _, err := IndexMask(Binomial(NumberOfCards, 7), 7); !errors.Is(err, ErrInvalidIndex) {
+		t.Fatalf("Expecting ErrInvalidIndex, got %v", err)
+	}
+	if _, err := IndexMask(0, 53); !errors.Is(err, ErrInvalidCardCount) {
+		t.Fatalf("Expecting ErrInvalidCardCount, got %v", err)
+	}
+	if _, err := MaskIndex(JokerMask); !errors.Is(err, ErrInvalidCard) {
+		t.Fatalf("Expecting ErrInvalidCard, got %v", err)
+	}
+
+	all, err := IndexMask(0, NumberOfCards)
+	if err != nil || all != FullDeck {
+		t.Fatalf("IndexMask(0, 52) = %x, %v", all, err)
+	}
+}
+
+func TestHandsRangeSlice(t *testing.T) {
+	const numCards = 4
+	total := Binomial(NumberOfCards, numCards)
+
+	// the slices put together visit every hand once, in index order
+	next := uint64(0)
+	for start := uint64(0); start < total; start += 10007 {
+		err := HandsRangeSlice(numCards, start, start+10007, func(mask uint64) {
+			if index, _ := MaskIndex(mask); index != next {
+				t.Fatalf("Hand %s has index %d, expecting %d", MaskToString(mask), index, next)
+			}
+			next++
+		})
+		if err != nil {
+			t.Fatalf("HandsRangeSlice() failed: %v", err)
+		}
+	}
+
+	if next != total {
+		t.Fatalf("Incorrect number of hands. Want %d, Got %d", total, next)
+	}
+
+	count := 0
+	if err := HandsRangeSlice(7, 100, 100, func(uint64) { count++ }); err != nil || count != 0 {
+		t.Fatalf("Expecting an empty slice, got %d hands, %v", count, err)
+	}
+	if err := HandsRangeSlice(60, 0, 1, func(uint64) {}); !errors.Is(err, ErrInvalidCardCount) {
+		t.Fatalf("Expecting ErrInvalidCardCount, got %v", err)
+	}
+}
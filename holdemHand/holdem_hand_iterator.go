@@ -1,9 +1,16 @@
 package holdemHand
 
+import (
+	"math/bits"
+)
+
 // slow
 func cardsRange(mask uint64) <-chan string {
 	channel := make(chan string)
 	go func() {
+		if mask&JokerMask != 0 {
+			channel <- JokerText
+		}
 		for i := 51; i >= 0; i-- {
 			if (uint64(1)<<i)&mask != 0 {
 				channel <- CardTable[i]
@@ -16,6 +23,9 @@ func cardsRange(mask uint64) <-chan string {
 }
 
 func cardsRange2(mask uint64, callback func(string)) {
+	if mask&JokerMask != 0 {
+		callback(JokerText)
+	}
 	for i := 51; i >= 0; i-- {
 		if (uint64(1)<<i)&mask != 0 {
 			callback(CardTable[i])
@@ -257,3 +267,43 @@ func HandsRange2(numCards int, callback func(uint64)) {
 	}
 
 }
+
+// Enumerates every hand of numCards cards that contains the shared cards and none of
+// the dead cards. The hands are passed to the callback in the same order as HandsRange2.
+func HandsRangeShared(shared uint64, dead uint64, numCards int, callback func(uint64)) {
+	DeckHandsRange(FullDeck, shared, dead, numCards, callback)
+}
+
+// Same as HandsRangeShared() but the cards are drawn from the given deck, for games
+// that don't use the standard 52 card deck.
+func DeckHandsRange(deck uint64, shared uint64, dead uint64, numCards int, callback func(uint64)) {
+	n := numCards - bits.OnesCount64(shared)
+	if n < 0 {
+		return
+	}
+
+	cards := deckCards(deck &^ (dead | shared))
+	handsRangeCards(cards, shared, n, callback)
+}
+
+// lists the cards in the deck in card order
+func deckCards(deck uint64) []uint64 {
+	cards := make([]uint64, 0, NumberOfCardsWithJoker)
+	for a := 0; a < NumberOfCardsWithJoker; a++ {
+		if deck&(uint64(1)<<a) != 0 {
+			cards = append(cards, uint64(1)<<a)
+		}
+	}
+	return cards
+}
+
+func handsRangeCards(cards []uint64, mask uint64, numCards int, callback func(uint64)) {
+	if numCards == 0 {
+		callback(mask)
+		return
+	}
+
+	for a := 0; a <= len(cards)-numCards; a++ {
+		handsRangeCards(cards[a+1:], mask|cards[a], numCards-1, callback)
+	}
+}
@@ -289,3 +289,19 @@ func TestEvaluateMask(t *testing.T) {
 		t.Fatalf("EvaluateHandText() failed. Want %s but got %s", want, got)
 	}
 }
+
+func TestEvaluateMaskRanks(t *testing.T) {
+	handValue, _ := EvaluateHandText("Ah Ad Ac Kh Kd 2c 2d")
+	want := "A fullhouse, Ace's and King's"
+	got := HandDescriptionFromHandType(handValue)
+	if got != want {
+		t.Fatalf("EvaluateHandText() failed. Want %s but got %s", want, got)
+	}
+
+	// the kickers of three of a kind are the two highest other cards
+	better, _ := EvaluateHandText("Ks 3s Kh Ad Kd 9d 7c")
+	worse, _ := EvaluateHandText("Ks 3s Kh Qd Kd 9d 7c")
+	if better <= worse {
+		t.Fatalf("Trip kings with an ace kicker beat trip kings with a queen kicker")
+	}
+}
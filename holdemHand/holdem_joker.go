@@ -0,0 +1,86 @@
+package holdemHand
+
+import (
+	"errors"
+)
+
+// Joker rules
+const (
+	// The joker can stand in for any card, including a fifth card
+	// of a rank already held (five of a kind)
+	JokerFullWild = iota
+	// The joker ("bug") may only be used to complete a straight or a flush,
+	// otherwise it plays as an ace
+	JokerBug
+)
+
+// Evaluates a hand mask that may contain the joker (CardJoker) and returns a hand value.
+// Without a joker this is the same as EvaluateMask(). With a joker, the best hand
+// allowed by the given joker rule is returned. Five of a kind is only possible
+// when the joker is in the hand.
+func EvaluateMaskWithJoker(mask uint64, rule int) (uint, error) {
+	if mask&JokerMask == 0 {
+		return EvaluateMask(mask)
+	}
+
+	if rule != JokerFullWild && rule != JokerBug {
+		return 0, errors.New("Invalid joker rule")
+	}
+
+	cards := mask &^ JokerMask
+	numCards := bitCount(cards) + 1
+	if numCards > 7 || cards&^((uint64(1)<<NumberOfCards)-1) != 0 {
+		return 0, ErrInvalidCardCount
+	}
+
+	sc := uint((cards >> CLUB_OFFSET) & 0x1FFF)
+	sd := uint((cards >> DIAMOND_OFFSET) & 0x1FFF)
+	sh := uint((cards >> HEART_OFFSET) & 0x1FFF)
+	ss := uint((cards >> SPADE_OFFSET) & 0x1FFF)
+
+	// four of a kind plus the joker can't be beaten
+	fourMask := sc & sd & sh & ss
+	if rule == JokerBug {
+		fourMask &= uint(1) << RankAce
+	}
+	if fourMask != 0 {
+		return HANDTYPE_VALUE_FIVE_OF_A_KIND + TopCardTable[fourMask]<<TOP_CARD_SHIFT, nil
+	}
+
+	// try the joker as every card that isn't already in the hand
+	best := uint(0)
+	for card := 0; card < NumberOfCards; card++ {
+		cardMask := CardMasksTable[card]
+		if cards&cardMask != 0 {
+			continue
+		}
+
+		value, err := EvaluateMask(cards | cardMask)
+		if err != nil {
+			return 0, err
+		}
+
+		if rule == JokerBug && card%13 != RankAce {
+			handType := getHandType(value)
+			if handType != Straight && handType != Flush && handType != StraightFlush {
+				continue
+			}
+		}
+
+		if value > best {
+			best = value
+		}
+	}
+
+	return best, nil
+}
+
+// Evaluates a hand passed as a string that may contain a joker (Xx or Jk)
+// and returns a hand value.
+func EvaluateHandTextWithJoker(hand string, rule int) (uint, error) {
+	mask, e := ParseHand(hand)
+	if e != nil {
+		return 0, e
+	}
+	return EvaluateMaskWithJoker(mask, rule)
+}
@@ -0,0 +1,98 @@
+package holdemHand
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseJoker(t *testing.T) {
+	Assert(t, func() bool { return ParseCard("Xx") != CardJoker }, "Xx is the joker")
+	Assert(t, func() bool { return ParseCard("Jk") != CardJoker }, "Jk is the joker")
+	Assert(t, func() bool { return ValidateHand("Xx Jk") }, "Xx Jk is the same card twice")
+	Assert(t, func() bool { return ValidateHand("Xs") }, "Xs is not a valid card")
+
+	mask, err := ParseHand("As Xx")
+	if err != nil {
+		t.Fatalf("Unable to parse As Xx")
+	}
+	if mask&JokerMask == 0 {
+		t.Fatalf("Expecting the joker bit to be set")
+	}
+
+	got := MaskToString(mask)
+	if got != "Xx As" {
+		t.Fatalf("MaskToString output does not match hand. Want Xx As, Got %s", got)
+	}
+}
+
+func TestEvaluateMaskRejectsJoker(t *testing.T) {
+	if _, err := EvaluateHandText("As Ks Xx"); err == nil {
+		t.Fatalf("EvaluateHandText should reject the joker")
+	}
+	if _, err := EvaluateMask(uint64(1)<<60 | 1); err == nil {
+		t.Fatalf("EvaluateMask should reject bits above the deck")
+	}
+}
+
+func TestEvaluateJokerFullWild(t *testing.T) {
+	tests := []struct {
+		hand string
+		want string
+	}{
+		{"As Ah Ad Ac Xx", "Five of a kind, Ace's"},
+		{"2h 3h 4h 5h Xx", "A straight flush"},
+		{"Ks Kh Kd Xx 2c", "Four of a kind, King's"},
+		{"Ks Kh 2d 2c Xx", "A fullhouse, King's and Two's"},
+		{"Xx", "High card: Ace"},
+	}
+
+	for _, test := range tests {
+		handValue, err := EvaluateHandTextWithJoker(test.hand, JokerFullWild)
+		if err != nil {
+			t.Fatalf("Unable to evaluate %s: %v", test.hand, err)
+		}
+		got := HandDescriptionFromHandType(handValue)
+		if !strings.Contains(got, test.want) {
+			t.Fatalf("EvaluateHandTextWithJoker(%s) failed. Want %s but got %s", test.hand, test.want, got)
+		}
+	}
+}
+
+func TestEvaluateJokerBug(t *testing.T) {
+	tests := []struct {
+		hand string
+		want string
+	}{
+		{"As Ah Ad Ac Xx", "Five of a kind, Ace's"},
+		{"Ks Kh Kd Kc Xx", "Four of a kind, King's"},
+		{"Ks Kh Kd Xx 2c", "Three of a kind, King's"},
+		{"9h Th Jh Qh Xx", "A straight flush"},
+		{"9h Tc Jh Qh Xx", "A straight, King high"},
+		{"2d 7d 9d Jd Xx", "A flush, Ace high"},
+		{"As 7h Xx", "One pair, Ace"},
+	}
+
+	for _, test := range tests {
+		handValue, err := EvaluateHandTextWithJoker(test.hand, JokerBug)
+		if err != nil {
+			t.Fatalf("Unable to evaluate %s: %v", test.hand, err)
+		}
+		got := HandDescriptionFromHandType(handValue)
+		if !strings.Contains(got, test.want) {
+			t.Fatalf("EvaluateHandTextWithJoker(%s) failed. Want %s but got %s", test.hand, test.want, got)
+		}
+	}
+}
+
+func TestEvaluateJokerNeverWorse(t *testing.T) {
+	// adding the joker to a hand can only improve it
+	HandsRange2(4, func(mask uint64) {
+		without, _ := EvaluateMask(mask)
+		for _, rule := range []int{JokerFullWild, JokerBug} {
+			with, err := EvaluateMaskWithJoker(mask|JokerMask, rule)
+			if err != nil || with < without {
+				t.Fatalf("Joker made %s worse", MaskToString(mask))
+			}
+		}
+	})
+}
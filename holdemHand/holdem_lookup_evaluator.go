@@ -0,0 +1,259 @@
+package holdemHand
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"sync"
+)
+
+// Anything that can evaluate a hand mask into a hand value
+type Evaluator interface {
+	EvaluateMask(mask uint64) (uint, error)
+}
+
+// The bit twiddling evaluator, see EvaluateMask()
+type MaskEvaluator struct{}
+
+func (MaskEvaluator) EvaluateMask(mask uint64) (uint, error) {
+	return EvaluateMask(mask)
+}
+
+// A table driven seven card evaluator. Seven card hands with a flush are looked up
+// by the ranks of the flush suit. All other seven card hands are looked up by a
+// perfect hash of their ranks: every rank has a key chosen so that the sums of
+// the keys of any seven ranks (up to four of each) are all different.
+// It returns the same hand values as EvaluateMask(). Hands with less than seven cards
+// are passed on to EvaluateMask().
+type LookupEvaluator struct {
+	// the hand values of the non flush hands
+	values []uint32
+	// the index in values of each rank hash, zero when there is no such hand
+	ranks []uint16
+	// the hand value of each flush suit with five to seven cards
+	flushes []uint32
+	// the sum of the rank keys of each suit
+	suitKeys []uint32
+}
+
+var lookupRankKeys = [13]uint32{0, 1, 5, 22, 98, 453, 2031, 8698, 22854, 83661, 262349, 636345, 1479181}
+
+const (
+	lookupFileMagic   uint32 = 0x4C564548 // HEVL
+	lookupFileVersion uint32 = 1
+)
+
+var (
+	defaultLookupEvaluator     *LookupEvaluator
+	defaultLookupEvaluatorErr  error
+	defaultLookupEvaluatorOnce sync.Once
+)
+
+// Returns a LookupEvaluator shared by the whole program, the tables are built on first use.
+func DefaultLookupEvaluator() (*LookupEvaluator, error) {
+	defaultLookupEvaluatorOnce.Do(func() {
+		defaultLookupEvaluator, defaultLookupEvaluatorErr = NewLookupEvaluator()
+	})
+	return defaultLookupEvaluator, defaultLookupEvaluatorErr
+}
+
+// Builds the lookup tables by evaluating every seven card rank combination with EvaluateMask().
+func NewLookupEvaluator() (*LookupEvaluator, error) {
+	e := &LookupEvaluator{
+		values:  []uint32{0},
+		ranks:   make([]uint16, 4*lookupRankKeys[RankAce]+3*lookupRankKeys[RankKing]+1),
+		flushes: make([]uint32, 1<<13),
+	}
+	e.buildSuitKeys()
+
+	var counts [13]int
+	var err error
+	var buildRanks func(rank int, cards int)
+	buildRanks = func(rank int, cards int) {
+		if err != nil {
+			return
+		}
+
+		if rank < 0 {
+			if cards == 0 {
+				err = e.addRanks(&counts)
+			}
+			return
+		}
+
+		for count := 0; count <= 4 && count <= cards; count++ {
+			counts[rank] = count
+			buildRanks(rank-1, cards-count)
+		}
+		counts[rank] = 0
+	}
+	buildRanks(RankAce, 7)
+	if err != nil {
+		return nil, err
+	}
+
+	for suit := range e.flushes {
+		if BitsTable[suit] >= 5 && BitsTable[suit] <= 7 {
+			value, err := EvaluateMask(uint64(suit) << SPADE_OFFSET)
+			if err != nil {
+				return nil, err
+			}
+			e.flushes[suit] = uint32(value)
+		}
+	}
+
+	return e, nil
+}
+
+// Reads tables written by WriteTo(), this is faster than building them.
+func LoadLookupEvaluator(r io.Reader) (*LookupEvaluator, error) {
+	hash := crc32.NewIEEE()
+	reader := io.TeeReader(bufio.NewReader(r), hash)
+
+	var header [3]uint32
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header[0] != lookupFileMagic || header[1] != lookupFileVersion || header[2] > 1<<16 {
+		return nil, errors.New("Not a lookup evaluator file")
+	}
+
+	e := &LookupEvaluator{
+		values:  make([]uint32, header[2]),
+		ranks:   make([]uint16, 4*lookupRankKeys[RankAce]+3*lookupRankKeys[RankKing]+1),
+		flushes: make([]uint32, 1<<13),
+	}
+	for _, table := range []any{e.values, e.ranks, e.flushes} {
+		if err := binary.Read(reader, binary.LittleEndian, table); err != nil {
+			return nil, err
+		}
+	}
+
+	sum := hash.Sum32()
+	var checksum uint32
+	if err := binary.Read(reader, binary.LittleEndian, &checksum); err != nil {
+		return nil, err
+	}
+	if checksum != sum {
+		return nil, errors.New("Lookup evaluator file is corrupt")
+	}
+
+	for _, index := range e.ranks {
+		if int(index) >= len(e.values) {
+			return nil, errors.New("Lookup evaluator file is corrupt")
+		}
+	}
+
+	e.buildSuitKeys()
+	return e, nil
+}
+
+// Writes the tables so they can be loaded with LoadLookupEvaluator().
+func (e *LookupEvaluator) WriteTo(w io.Writer) (int64, error) {
+	hash := crc32.NewIEEE()
+	writer := bufio.NewWriter(w)
+	counter := &countingWriter{w: io.MultiWriter(writer, hash)}
+
+	header := [3]uint32{lookupFileMagic, lookupFileVersion, uint32(len(e.values))}
+	for _, table := range []any{header, e.values, e.ranks, e.flushes} {
+		if err := binary.Write(counter, binary.LittleEndian, table); err != nil {
+			return counter.n, err
+		}
+	}
+
+	if err := binary.Write(counter, binary.LittleEndian, hash.Sum32()); err != nil {
+		return counter.n, err
+	}
+	return counter.n, writer.Flush()
+}
+
+// The memory used by the lookup tables in bytes
+func (e *LookupEvaluator) MemoryBytes() int {
+	return 4*len(e.values) + 2*len(e.ranks) + 4*len(e.flushes) + 4*len(e.suitKeys)
+}
+
+// Evaluates a hand mask and returns the same hand value as EvaluateMask().
+func (e *LookupEvaluator) EvaluateMask(mask uint64) (uint, error) {
+	if bitCount(mask) != 7 || mask>>NumberOfCards != 0 {
+		return EvaluateMask(mask)
+	}
+	return e.Evaluate7(mask), nil
+}
+
+// Evaluates a mask of exactly seven cards. The mask isn't checked.
+func (e *LookupEvaluator) Evaluate7(mask uint64) uint {
+	sc := uint((mask >> CLUB_OFFSET) & 0x1FFF)
+	sd := uint((mask >> DIAMOND_OFFSET) & 0x1FFF)
+	sh := uint((mask >> HEART_OFFSET) & 0x1FFF)
+	ss := uint((mask >> SPADE_OFFSET) & 0x1FFF)
+
+	// there can only be one suit with five or more cards
+	if flush := e.flushes[sc] | e.flushes[sd] | e.flushes[sh] | e.flushes[ss]; flush != 0 {
+		return uint(flush)
+	}
+
+	key := e.suitKeys[sc] + e.suitKeys[sd] + e.suitKeys[sh] + e.suitKeys[ss]
+	return uint(e.values[e.ranks[key]])
+}
+
+func (e *LookupEvaluator) buildSuitKeys() {
+	e.suitKeys = make([]uint32, 1<<13)
+	for suit := range e.suitKeys {
+		for rank := 0; rank < 13; rank++ {
+			if suit&(1<<rank) != 0 {
+				e.suitKeys[suit] += lookupRankKeys[rank]
+			}
+		}
+	}
+}
+
+// adds the hand made up of the given number of cards of each rank
+func (e *LookupEvaluator) addRanks(counts *[13]int) error {
+	// deal the cards of each rank to the suits with the fewest cards,
+	// so no suit gets more than two cards and there can't be a flush
+	var suits [4]int
+	mask := uint64(0)
+	key := uint32(0)
+	for rank, count := range counts {
+		key += uint32(count) * lookupRankKeys[rank]
+		for ; count > 0; count-- {
+			suit := -1
+			for s := 0; s < 4; s++ {
+				if mask&CardMasksTable[rank+13*s] == 0 && (suit < 0 || suits[s] < suits[suit]) {
+					suit = s
+				}
+			}
+			suits[suit]++
+			mask |= CardMasksTable[rank+13*suit]
+		}
+	}
+
+	if e.ranks[key] != 0 {
+		return errors.New("Lookup evaluator rank keys collide")
+	}
+
+	value, err := EvaluateMask(mask)
+	if err != nil {
+		return err
+	}
+
+	e.values = append(e.values, uint32(value))
+	if len(e.values) > 1<<16 {
+		return errors.New("Too many lookup evaluator hand values")
+	}
+	e.ranks[key] = uint16(len(e.values) - 1)
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
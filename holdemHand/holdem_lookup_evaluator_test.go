@@ -0,0 +1,96 @@
+package holdemHand
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLookupEvaluator(t *testing.T) {
+	e, err := DefaultLookupEvaluator()
+	if err != nil {
+		t.Fatalf("Unable to build the lookup evaluator: %v", err)
+	}
+
+	for _, hand := range []string{
+		"Ad Kh 8c 5s 6c Js 10h",
+		"Ah Ad Ac Kh Kd 2c 2d",
+		"As Ah Ad Ac Kd Kc Ks",
+		"8d 9d As Kd Jd 7d Td",
+		"2c 3d 4c 5s 6c Ad Ah",
+		"Ad Kh",
+	} {
+		mask, _ := ParseHand(hand)
+		want, _ := EvaluateMask(mask)
+		got, _ := e.EvaluateMask(mask)
+		if got != want {
+			t.Fatalf("Incorrect hand value for %s. Want %#x, Got %#x", hand, want, got)
+		}
+	}
+
+	if _, err := e.EvaluateMask(0); err == nil {
+		t.Fatalf("Expecting an error for an empty hand")
+	}
+
+	if e.MemoryBytes() < 1<<20 {
+		t.Fatalf("The lookup tables should take megabytes, got %d bytes", e.MemoryBytes())
+	}
+}
+
+func TestLookupEvaluatorFile(t *testing.T) {
+	e, _ := DefaultLookupEvaluator()
+	buf := bytes.Buffer{}
+	n, err := e.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		t.Fatalf("Unable to write the lookup evaluator: %v", err)
+	}
+
+	loaded, err := LoadLookupEvaluator(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Unable to load the lookup evaluator: %v", err)
+	}
+
+	HandsRangeShared(0, 0x5555555555555, 7, func(mask uint64) {
+		if loaded.Evaluate7(mask) != e.Evaluate7(mask) {
+			t.Fatalf("Loaded evaluator differs on %s", MaskToString(mask))
+		}
+	})
+
+	corrupt := bytes.Clone(buf.Bytes())
+	corrupt[len(corrupt)/2]++
+	if _, err := LoadLookupEvaluator(bytes.NewReader(corrupt)); err == nil {
+		t.Fatalf("Expecting an error for a corrupt file")
+	}
+}
+
+func TestLookupEvaluatorAllHands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping all 133,784,560 seven card hands in short mode")
+	}
+
+	e, _ := DefaultLookupEvaluator()
+	count := 0
+	HandsRange2(7, func(mask uint64) {
+		want, _ := EvaluateMask(mask)
+		if got := e.Evaluate7(mask); got != want {
+			t.Fatalf("Incorrect hand value for %s. Want %#x, Got %#x", MaskToString(mask), want, got)
+		}
+		count++
+	})
+
+	if count != 133784560 {
+		t.Fatalf("Incorrect number of hands. Want 133784560, Got %d", count)
+	}
+}
+
+func TestHoldemGameEvaluator(t *testing.T) {
+	e, _ := DefaultLookupEvaluator()
+	game := HoldemGame{Evaluator: e}
+	pocket, _ := ParseHand("As Ah")
+	board, _ := ParseHand("Ac Ks 6c Js 10h")
+
+	want, _ := Holdem.EvaluateHigh(pocket, board)
+	got, err := game.EvaluateHigh(pocket, board)
+	if err != nil || got != want {
+		t.Fatalf("Incorrect hand value. Want %#x, Got %#x", want, got)
+	}
+}
@@ -0,0 +1,356 @@
+package holdemHand
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A single card, written as As. The joker is CardJoker.
+type Card int
+
+// A set of cards, the same as a hand mask. It is written as a string such as
+// "As Kh" and can be read from a string or from an array of cards.
+type CardSet uint64
+
+// A hand value returned by EvaluateMask(). In JSON it is an object with the hand
+// type, the ranks that decide ties, the description and the value itself.
+type HandValue uint
+
+var ErrInvalidHandValue = errors.New("Invalid hand value")
+
+// the JSON form of a HandValue
+type handValueJSON struct {
+	Type        string   `json:"type"`
+	Ranks       []string `json:"ranks"`
+	Description string   `json:"description"`
+	Value       uint     `json:"value"`
+}
+
+// the number of ranks that decide ties for each hand type
+var handTypeRanks = [FiveOfAKind + 1]int{5, 4, 3, 3, 1, 5, 2, 2, 1, 1}
+
+func (card Card) String() string {
+	if card < 0 || card > CardJoker {
+		return ""
+	}
+	return cardText(int(card))
+}
+
+func (card Card) MarshalText() ([]byte, error) {
+	if card < 0 || card > CardJoker {
+		return nil, ErrInvalidCard
+	}
+	return []byte(card.String()), nil
+}
+
+func (card *Card) UnmarshalText(text []byte) error {
+	c, err := AnyNotation.ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+	*card = Card(c)
+	return nil
+}
+
+func (card Card) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(card)
+}
+
+func (card *Card) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, card)
+}
+
+// A card is one byte
+func (card Card) MarshalBinary() ([]byte, error) {
+	if card < 0 || card > CardJoker {
+		return nil, ErrInvalidCard
+	}
+	return []byte{byte(card)}, nil
+}
+
+func (card *Card) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || data[0] > CardJoker {
+		return ErrInvalidCard
+	}
+	*card = Card(data[0])
+	return nil
+}
+
+func (set CardSet) String() string {
+	return MaskToString(uint64(set))
+}
+
+// The cards in SuitRankOrder
+func (set CardSet) Cards() CardList {
+	return MaskCards(uint64(set))
+}
+
+func (set CardSet) MarshalText() ([]byte, error) {
+	if uint64(set)>>(CardJoker+1) != 0 {
+		return nil, ErrInvalidCard
+	}
+	return AppendMaskString(nil, uint64(set)), nil
+}
+
+func (set *CardSet) UnmarshalText(text []byte) error {
+	mask, err := AnyNotation.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*set = CardSet(mask)
+	return nil
+}
+
+func (set CardSet) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(set)
+}
+
+// Reads a string such as "As Kh" or an array such as ["As", "Kh"]
+func (set *CardSet) UnmarshalJSON(data []byte) error {
+	var cards CardList
+	if err := cards.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*set = CardSet(cards.Mask())
+	return nil
+}
+
+// A card set is eight bytes, little endian
+func (set CardSet) MarshalBinary() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, uint64(set)), nil
+}
+
+func (set *CardSet) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return ErrBadHand
+	}
+	mask := binary.LittleEndian.Uint64(data)
+	if mask>>(CardJoker+1) != 0 {
+		return ErrInvalidCard
+	}
+	*set = CardSet(mask)
+	return nil
+}
+
+func (cards CardList) MarshalText() ([]byte, error) {
+	return []byte(cards.String()), nil
+}
+
+func (cards *CardList) UnmarshalText(text []byte) error {
+	list, err := AnyNotation.ParseList(string(text))
+	if err != nil {
+		return err
+	}
+	*cards = list
+	return nil
+}
+
+// A card list is written as an array of cards, keeping its order
+func (cards CardList) MarshalJSON() ([]byte, error) {
+	list := make([]Card, len(cards))
+	for i, card := range cards {
+		list[i] = Card(card)
+	}
+	return json.Marshal(list)
+}
+
+// Reads an array such as ["As", "Kh"] or a string such as "As Kh"
+func (cards *CardList) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return cards.UnmarshalText([]byte(text))
+	}
+
+	var list []Card
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	result := make(CardList, len(list))
+	mask := uint64(0)
+	for i, card := range list {
+		if mask&(uint64(1)<<card) != 0 {
+			return fmt.Errorf("%w: %s is duplicated", ErrBadHand, card)
+		}
+		mask |= uint64(1) << card
+		result[i] = int(card)
+	}
+	*cards = result
+	return nil
+}
+
+// A card list is one byte per card
+func (cards CardList) MarshalBinary() ([]byte, error) {
+	data := make([]byte, len(cards))
+	for i, card := range cards {
+		if card < 0 || card > CardJoker {
+			return nil, ErrInvalidCard
+		}
+		data[i] = byte(card)
+	}
+	return data, nil
+}
+
+func (cards *CardList) UnmarshalBinary(data []byte) error {
+	list := make(CardList, len(data))
+	for i, card := range data {
+		if card > CardJoker {
+			return ErrInvalidCard
+		}
+		list[i] = int(card)
+	}
+	*cards = list
+	return nil
+}
+
+// The hand type, such as Flush
+func (value HandValue) Type() int {
+	return int(getHandType(uint(value)))
+}
+
+// The ranks that decide between hands of the same type, highest first. For a full
+// house these are the trips and the pair, for a straight the top card.
+func (value HandValue) Ranks() []int {
+	handType := value.Type()
+	if handType >= len(handTypeRanks) {
+		return nil
+	}
+
+	ranks := make([]int, handTypeRanks[handType])
+	for i := range ranks {
+		ranks[i] = int((uint(value) >> (TOP_CARD_SHIFT - CARD_WIDTH*uint(i))) & CARD_MASK)
+	}
+	return ranks
+}
+
+func (value HandValue) String() string {
+	return HandDescriptionFromHandType(uint(value))
+}
+
+// Writes the hand type and the ranks, such as "Full house K 7"
+func (value HandValue) MarshalText() ([]byte, error) {
+	handType := value.Type()
+	if handType >= len(HandTypeTable) {
+		return nil, fmt.Errorf("%w %#x", ErrInvalidHandValue, uint(value))
+	}
+
+	text := []byte(HandTypeTable[handType])
+	for _, rank := range value.Ranks() {
+		text = append(text, ' ', CardTable[rank][0])
+	}
+	return text, nil
+}
+
+func (value *HandValue) UnmarshalText(text []byte) error {
+	// look for the longest name first, so a straight flush isn't taken for a straight
+	handType := -1
+	for t, name := range HandTypeTable {
+		if len(text) >= len(name) && strings.EqualFold(string(text[:len(name)]), name) &&
+			(handType < 0 || len(name) > len(HandTypeTable[handType])) {
+			handType = t
+		}
+	}
+	if handType < 0 {
+		return fmt.Errorf("%w %q", ErrInvalidHandValue, text)
+	}
+
+	return value.set(handType, strings.Fields(string(text[len(HandTypeTable[handType]):])))
+}
+
+func (value HandValue) MarshalJSON() ([]byte, error) {
+	handType := value.Type()
+	if handType >= len(HandTypeTable) {
+		return nil, fmt.Errorf("%w %#x", ErrInvalidHandValue, uint(value))
+	}
+
+	ranks := []string{}
+	for _, rank := range value.Ranks() {
+		ranks = append(ranks, CardTable[rank][:1])
+	}
+	return json.Marshal(handValueJSON{
+		Type:        HandTypeTable[handType],
+		Ranks:       ranks,
+		Description: value.String(),
+		Value:       uint(value),
+	})
+}
+
+// Reads the object written by MarshalJSON(). The value is used when it is there,
+// otherwise the hand value is put together from the type and the ranks.
+func (value *HandValue) UnmarshalJSON(data []byte) error {
+	var object handValueJSON
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if object.Value != 0 {
+		if getHandType(object.Value) >= uint(len(HandTypeTable)) {
+			return fmt.Errorf("%w %#x", ErrInvalidHandValue, object.Value)
+		}
+		*value = HandValue(object.Value)
+		return nil
+	}
+
+	for handType, name := range HandTypeTable {
+		if strings.EqualFold(object.Type, name) {
+			return value.set(handType, object.Ranks)
+		}
+	}
+	return fmt.Errorf("%w: hand type %q", ErrInvalidHandValue, object.Type)
+}
+
+// A hand value is four bytes, little endian
+func (value HandValue) MarshalBinary() ([]byte, error) {
+	return binary.LittleEndian.AppendUint32(nil, uint32(value)), nil
+}
+
+func (value *HandValue) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("%w: %d bytes", ErrInvalidHandValue, len(data))
+	}
+	read := uint(binary.LittleEndian.Uint32(data))
+	if getHandType(read) >= uint(len(HandTypeTable)) {
+		return fmt.Errorf("%w %#x", ErrInvalidHandValue, read)
+	}
+	*value = HandValue(read)
+	return nil
+}
+
+// puts a hand value together from its type and ranks
+func (value *HandValue) set(handType int, ranks []string) error {
+	if len(ranks) > handTypeRanks[handType] {
+		return fmt.Errorf("%w: too many ranks for a %s", ErrInvalidHandValue, strings.ToLower(HandTypeTable[handType]))
+	}
+
+	result := uint(handType) << HANDTYPE_SHIFT
+	for i, text := range ranks {
+		rank, size := AnyNotation.rank(text)
+		if rank < 0 || size != len(text) {
+			return fmt.Errorf("%w: rank %q", ErrInvalidHandValue, text)
+		}
+		result |= uint(rank) << (TOP_CARD_SHIFT - CARD_WIDTH*uint(i))
+	}
+	*value = HandValue(result)
+	return nil
+}
+
+// writes a value's text form as a JSON string
+func marshalJSONText(value interface{ MarshalText() ([]byte, error) }) ([]byte, error) {
+	text, err := value.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// reads a JSON string into a value's text form
+func unmarshalJSONText(data []byte, value interface{ UnmarshalText([]byte) error }) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return value.UnmarshalText([]byte(text))
+}
@@ -0,0 +1,159 @@
+package holdemHand
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCardMarshal(t *testing.T) {
+	for card := Card(0); card <= CardJoker; card++ {
+		data, err := json.Marshal(card)
+		if err != nil {
+			t.Fatalf("Unable to marshal card %d: %v", card, err)
+		}
+
+		var again Card
+		if err := json.Unmarshal(data, &again); err != nil || again != card {
+			t.Fatalf("%s reads back as %d, %v", data, again, err)
+		}
+
+		binary, _ := card.MarshalBinary()
+		again = -1
+		if err := again.UnmarshalBinary(binary); err != nil || again != card {
+			t.Fatalf("Card %d reads back from binary as %d, %v", card, again, err)
+		}
+	}
+
+	data, _ := json.Marshal(Card(51))
+	if string(data) != `"As"` {
+		t.Fatalf("Incorrect card JSON %s", data)
+	}
+
+	var card Card
+	if err := json.Unmarshal([]byte(`"Zz"`), &card); err == nil {
+		t.Fatal("Expecting an error reading a bad card")
+	}
+	if _, err := Card(60).MarshalText(); err == nil {
+		t.Fatal("Expecting an error writing a bad card")
+	}
+}
+
+func TestCardSetMarshal(t *testing.T) {
+	mask, _ := ParseHand("As Kh 2c")
+	set := CardSet(mask)
+
+	type hand struct {
+		Cards CardSet  `json:"cards"`
+		Dealt CardList `json:"dealt"`
+	}
+	dealt, _ := ParseOptions{}.ParseList("Kh 2c As")
+	data, err := json.Marshal(hand{set, dealt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"cards":"As Kh 2c","dealt":["Kh","2c","As"]}`; string(data) != want {
+		t.Fatalf("Incorrect JSON. Want %s, Got %s", want, data)
+	}
+
+	var again hand
+	if err := json.Unmarshal(data, &again); err != nil || again.Cards != set || !reflect.DeepEqual(again.Dealt, dealt) {
+		t.Fatalf("%s reads back as %+v, %v", data, again, err)
+	}
+
+	// either form can be read into either type
+	if err := json.Unmarshal([]byte(`{"cards":["2c","As","Kh"],"dealt":"K♥ 2♣ A♠"}`), &again); err != nil ||
+		again.Cards != set || !reflect.DeepEqual(again.Dealt, dealt) {
+		t.Fatalf("Unable to read the other forms, got %+v, %v", again, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"cards":["As","As"]}`), &again); err == nil {
+		t.Fatal("Expecting an error for a duplicated card")
+	}
+
+	binary, _ := set.MarshalBinary()
+	var fromBinary CardSet
+	if err := fromBinary.UnmarshalBinary(binary); err != nil || fromBinary != set {
+		t.Fatalf("Incorrect card set from binary %v, %v", fromBinary, err)
+	}
+
+	listBinary, _ := dealt.MarshalBinary()
+	var listFromBinary CardList
+	if err := listFromBinary.UnmarshalBinary(listBinary); err != nil || !reflect.DeepEqual(listFromBinary, dealt) {
+		t.Fatalf("Incorrect card list from binary %v, %v", listFromBinary, err)
+	}
+}
+
+func TestHandValueMarshal(t *testing.T) {
+	value, _ := EvaluateHandText("Kc Kd Ks 7h 7c 2d 3s")
+	handValue := HandValue(value)
+
+	data, err := json.Marshal(handValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"Full house","ranks":["K","7"],"description":"A fullhouse, King's and Seven's","value":` +
+		string(must(json.Marshal(value))) + `}`
+	if string(data) != want {
+		t.Fatalf("Incorrect JSON. Want %s, Got %s", want, data)
+	}
+
+	var again HandValue
+	if err := json.Unmarshal(data, &again); err != nil || again != handValue {
+		t.Fatalf("%s reads back as %#x, %v", data, again, err)
+	}
+
+	// without the value it is put together from the type and ranks
+	if err := json.Unmarshal([]byte(`{"type":"full house","ranks":["K","7"]}`), &again); err != nil || again != handValue {
+		t.Fatalf("Incorrect hand value from type and ranks %#x, %v", again, err)
+	}
+
+	text, _ := handValue.MarshalText()
+	if string(text) != "Full house K 7" {
+		t.Fatalf("Incorrect text %q", text)
+	}
+
+	// every hand type survives the text form
+	count := 0
+	HandsRange2(5, func(mask uint64) {
+		if count++; count%11 != 0 {
+			return
+		}
+		value, _ := EvaluateMask(mask)
+		text, err := HandValue(value).MarshalText()
+		if err != nil {
+			t.Fatalf("Unable to write %#x: %v", value, err)
+		}
+		var again HandValue
+		if err := again.UnmarshalText(text); err != nil || uint(again) != value {
+			t.Fatalf("%q reads back as %#x instead of %#x, %v", text, again, value, err)
+		}
+	})
+
+	for _, bad := range []string{"Quints A", "Pair A K Q J T", "Pair Ax"} {
+		if err := again.UnmarshalText([]byte(bad)); !errors.Is(err, ErrInvalidHandValue) {
+			t.Fatalf("Expecting an error reading %q", bad)
+		}
+	}
+
+	binary, _ := handValue.MarshalBinary()
+	if err := again.UnmarshalBinary(binary); err != nil || again != handValue {
+		t.Fatalf("Incorrect hand value from binary %#x, %v", again, err)
+	}
+	for _, bad := range [][]byte{{1, 2, 3}, {0, 0, 0, 0xF}} {
+		if err := again.UnmarshalBinary(bad); !errors.Is(err, ErrInvalidHandValue) {
+			t.Fatalf("Expecting ErrInvalidHandValue reading %v, got %v", bad, err)
+		}
+	}
+	if _, err := HandValue(0xF << HANDTYPE_SHIFT).MarshalJSON(); !errors.Is(err, ErrInvalidHandValue) {
+		t.Fatalf("Expecting ErrInvalidHandValue, got %v", err)
+	}
+}
+
+func must(data []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
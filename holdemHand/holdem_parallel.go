@@ -0,0 +1,109 @@
+package holdemHand
+
+import (
+	"context"
+	"math/bits"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Options for the parallel enumerators
+type ParallelOptions struct {
+	// number of worker goroutines, GOMAXPROCS when zero
+	Workers int
+	// called as the workers get through the hands, never from two goroutines at once
+	Progress func(Progress)
+}
+
+// Enumerates the same hands as HandsRange2() on a pool of workers. Each worker gets
+// its own accumulator from newAcc and calls visit with it for every hand it is given,
+// so visit needs no locking. When all the hands are done the accumulators are merged
+// into the first one with merge, which is returned. The hands are not visited in order.
+// Returns the context's error if it is cancelled before all the hands are done.
+func HandsRangeParallel[T any](ctx context.Context, numCards int, options ParallelOptions,
+	newAcc func() T, visit func(acc T, mask uint64), merge func(dst T, src T)) (T, error) {
+	return DeckHandsRangeParallel(ctx, FullDeck, 0, 0, numCards, options, newAcc, visit, merge)
+}
+
+// Same as HandsRangeParallel() for the hands enumerated by DeckHandsRange().
+func DeckHandsRangeParallel[T any](ctx context.Context, deck uint64, shared uint64, dead uint64, numCards int,
+	options ParallelOptions, newAcc func() T, visit func(acc T, mask uint64), merge func(dst T, src T)) (T, error) {
+	n := numCards - bits.OnesCount64(shared)
+	if n < 0 {
+		return newAcc(), nil
+	}
+
+	// split the enumeration on the first two cards
+	cards := deckCards(deck &^ (dead | shared))
+	prefix := min(n, 2)
+	type unit struct {
+		mask  uint64
+		rest  []uint64
+		hands uint64
+	}
+	units := []unit{}
+	switch prefix {
+	case 0:
+		units = append(units, unit{mask: shared, hands: 1})
+	case 1:
+		for a := 0; a <= len(cards)-n; a++ {
+			units = append(units, unit{shared | cards[a], cards[a+1:], Binomial(len(cards)-a-1, n-1)})
+		}
+	default:
+		for a := 0; a <= len(cards)-n; a++ {
+			for b := a + 1; b <= len(cards)-n+1; b++ {
+				units = append(units, unit{shared | cards[a] | cards[b], cards[b+1:], Binomial(len(cards)-b-1, n-2)})
+			}
+		}
+	}
+
+	workers := options.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = max(1, min(workers, len(units)))
+
+	tracker := newProgressTracker(ctx, options.Progress, Binomial(len(cards), n))
+	var progressLock sync.Mutex
+	var next atomic.Int64
+	accs := make([]T, workers)
+	var wait sync.WaitGroup
+
+	for w := range accs {
+		accs[w] = newAcc()
+		wait.Add(1)
+		go func(acc T) {
+			defer wait.Done()
+			callback := func(mask uint64) { visit(acc, mask) }
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(units) || ctx.Err() != nil {
+					return
+				}
+
+				handsRangeCards(units[i].rest, units[i].mask, n-prefix, callback)
+
+				progressLock.Lock()
+				tracker.add(units[i].hands)
+				progressLock.Unlock()
+			}
+		}(accs[w])
+	}
+	wait.Wait()
+
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	tracker.done()
+	if len(units) == 0 {
+		return newAcc(), nil
+	}
+
+	for _, acc := range accs[1:] {
+		merge(accs[0], acc)
+	}
+	return accs[0], nil
+}
@@ -0,0 +1,79 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHandsRangeParallel(t *testing.T) {
+	var want [StraightFlush + 1]int
+	HandsRange2(5, func(mask uint64) {
+		want[EvaluateType(mask)]++
+	})
+
+	lastProgress := Progress{}
+	got, err := HandsRangeParallel(context.Background(), 5, ParallelOptions{
+		Workers:  4,
+		Progress: func(progress Progress) { lastProgress = progress },
+	},
+		func() *[StraightFlush + 1]int { return &[StraightFlush + 1]int{} },
+		func(handTypes *[StraightFlush + 1]int, mask uint64) { handTypes[EvaluateType(mask)]++ },
+		func(dst *[StraightFlush + 1]int, src *[StraightFlush + 1]int) {
+			for i := range dst {
+				dst[i] += src[i]
+			}
+		})
+	if err != nil {
+		t.Fatalf("HandsRangeParallel() failed: %v", err)
+	}
+
+	if *got != want {
+		t.Fatalf("Incorrect hand types. Want %v, Got %v", want, *got)
+	}
+
+	if lastProgress.Processed != 2598960 || lastProgress.Total != 2598960 {
+		t.Fatalf("Incorrect progress %+v", lastProgress)
+	}
+}
+
+func TestDeckHandsRangeParallel(t *testing.T) {
+	shared, _ := ParseHand("As")
+	dead, _ := ParseHand("2c 3c 4c")
+
+	for numCards := 0; numCards <= 4; numCards++ {
+		wantCount, wantSum := 0, uint64(0)
+		DeckHandsRange(FullDeck, shared, dead, numCards, func(mask uint64) {
+			wantCount++
+			wantSum += mask
+		})
+
+		type totals struct {
+			count int
+			sum   uint64
+		}
+		got, err := DeckHandsRangeParallel(context.Background(), FullDeck, shared, dead, numCards, ParallelOptions{},
+			func() *totals { return &totals{} },
+			func(acc *totals, mask uint64) { acc.count++; acc.sum += mask },
+			func(dst *totals, src *totals) { dst.count += src.count; dst.sum += src.sum })
+		if err != nil {
+			t.Fatalf("DeckHandsRangeParallel() failed: %v", err)
+		}
+
+		if got.count != wantCount || got.sum != wantSum {
+			t.Fatalf("Incorrect %d card hands. Want %d, Got %d", numCards, wantCount, got.count)
+		}
+	}
+}
+
+func TestHandsRangeParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := HandsRangeParallel(ctx, 7, ParallelOptions{Progress: func(Progress) { cancel() }},
+		func() *int { return new(int) },
+		func(count *int, mask uint64) { *count++ },
+		func(dst *int, src *int) { *dst += *src })
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expecting the enumeration to be cancelled, got %v", err)
+	}
+}
@@ -0,0 +1,289 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// The number of two card hands
+const PocketCount = NumberOfCards * (NumberOfCards - 1) / 2
+
+// The number of preflop hand classes: 13 pairs, 78 suited and 78 offsuit hands
+const PreflopClassCount = 13 * 13
+
+// Heads-up preflop all-in equities for every pair of pockets and every pair of
+// hand classes, built from an EquityCache holding the preflop matchups (see
+// GeneratePreflop()). Pockets are indexed by MaskIndex().
+type PreflopTable struct {
+	cache *EquityCache
+	// the equity of the first pocket, NaN when the pockets conflict or aren't in the cache
+	equities []float32
+	// the equity of the first class against the second, NaN when a matchup is missing
+	classes []float32
+}
+
+var ErrNoPreflopEquity = errors.New("No preflop equity for these hands")
+
+// Returns one pair of pockets for every preflop matchup that is different once the
+// suits are relabelled and the players are swapped, 47008 in all.
+func PreflopMatchups() [][2]uint64 {
+	seen := map[equityCacheKey]bool{}
+	matchups := [][2]uint64{}
+	for a := 0; a < len(TwoCardMaskTable); a++ {
+		for b := a + 1; b < len(TwoCardMaskTable); b++ {
+			pocket1, pocket2 := TwoCardMaskTable[a], TwoCardMaskTable[b]
+			if pocket1&pocket2 != 0 {
+				continue
+			}
+
+			key, _ := canonicalMatchupKey(pocket1, pocket2, 0)
+			if !seen[key] {
+				seen[key] = true
+				matchups = append(matchups, [2]uint64{key.pocket1, key.pocket2})
+			}
+		}
+	}
+	return matchups
+}
+
+// Works out the preflop equity of every matchup that isn't in the cache yet by
+// enumerating all the boards. The matchups are shared out to options.Workers workers,
+// each playing out one matchup at a time with a LookupEvaluator. Matchups are added
+// to the cache as they are done, so a cancelled generation can be carried on later.
+// progress counts matchups rather than boards.
+func (c *EquityCache) GeneratePreflop(ctx context.Context, options ParallelOptions) error {
+	return c.GenerateMatchups(ctx, PreflopMatchups(), options)
+}
+
+// Same as GeneratePreflop() for the given matchups.
+func (c *EquityCache) GenerateMatchups(ctx context.Context, matchups [][2]uint64, options ParallelOptions) error {
+	todo := [][2]uint64{}
+	seen := map[equityCacheKey]bool{}
+	for _, matchup := range matchups {
+		if _, err := equityBoards(Holdem, [][]uint64{{matchup[0]}, {matchup[1]}}, 0, 0); err != nil {
+			return err
+		}
+		if key, _ := canonicalMatchupKey(matchup[0], matchup[1], 0); !seen[key] && !c.contains(key) {
+			seen[key] = true
+			todo = append(todo, matchup)
+		}
+	}
+
+	evaluator, err := DefaultLookupEvaluator()
+	if err != nil {
+		return err
+	}
+	game := HoldemGame{Evaluator: evaluator}
+	deck := deckCards(FullDeck)
+
+	workers := options.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = max(1, min(workers, len(todo)))
+
+	tracker := newProgressTracker(ctx, options.Progress, uint64(len(todo)))
+	var progressLock sync.Mutex
+	var next atomic.Int64
+	var wait sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			acc := newEquityAccumulator(game, 2)
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(todo) || ctx.Err() != nil {
+					return
+				}
+
+				pockets := todo[i][:]
+				clear(acc.results)
+				clear(acc.shares)
+				cards := make([]uint64, 0, len(deck))
+				for _, card := range deck {
+					if card&(pockets[0]|pockets[1]) == 0 {
+						cards = append(cards, card)
+					}
+				}
+				handsRangeCardsUntil(cards, 0, 5, func(board uint64) bool {
+					acc.add(pockets, board)
+					return true
+				})
+
+				key, swapped := canonicalMatchupKey(pockets[0], pockets[1], 0)
+				c.add(key, newEquityCacheEntry(acc.results[0], swapped))
+
+				progressLock.Lock()
+				tracker.add(1)
+				progressLock.Unlock()
+			}
+		}()
+	}
+	wait.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tracker.done()
+	return nil
+}
+
+// Builds the equity tables from the preflop matchups in the cache. Matchups that
+// aren't in the cache are worked out by the cache when they are asked for.
+func NewPreflopTable(cache *EquityCache) *PreflopTable {
+	t := &PreflopTable{
+		cache:    cache,
+		equities: make([]float32, PocketCount*PocketCount),
+		classes:  make([]float32, PreflopClassCount*PreflopClassCount),
+	}
+
+	pockets := make([]uint64, PocketCount)
+	for index := range pockets {
+		pockets[index], _ = IndexMask(uint64(index), 2)
+	}
+
+	nan := float32(math.NaN())
+	var shares [PreflopClassCount * PreflopClassCount]float64
+	var counts [PreflopClassCount * PreflopClassCount]int
+	var missing [PreflopClassCount * PreflopClassCount]bool
+	for a := range t.equities {
+		t.equities[a] = nan
+	}
+
+	cache.lock.RLock()
+	for a, pocket1 := range pockets {
+		class1 := preflopClass(pocket1)
+		for b := a + 1; b < len(pockets); b++ {
+			pocket2 := pockets[b]
+			if pocket1&pocket2 != 0 {
+				continue
+			}
+
+			// fill in the matchup both ways round
+			class2 := preflopClass(pocket2)
+			key, swapped := canonicalMatchupKey(pocket1, pocket2, 0)
+			entry, ok := cache.entries[key]
+			if !ok {
+				missing[class1*PreflopClassCount+class2] = true
+				missing[class2*PreflopClassCount+class1] = true
+				continue
+			}
+
+			results := entry.results()
+			if swapped {
+				results[0], results[1] = results[1], results[0]
+			}
+			t.equities[a*PocketCount+b] = float32(results[0].Equity)
+			t.equities[b*PocketCount+a] = float32(results[1].Equity)
+			for i, class := range []int{class1*PreflopClassCount + class2, class2*PreflopClassCount + class1} {
+				shares[class] += results[i].Equity
+				counts[class]++
+			}
+		}
+	}
+	cache.lock.RUnlock()
+
+	for class := range t.classes {
+		t.classes[class] = nan
+		if counts[class] > 0 && !missing[class] {
+			t.classes[class] = float32(shares[class] / float64(counts[class]))
+		}
+	}
+	return t
+}
+
+// Reads an equity cache file with the preflop matchups and builds the tables.
+func LoadPreflopTable(r io.Reader) (*PreflopTable, error) {
+	cache, err := LoadEquityCache(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewPreflopTable(cache), nil
+}
+
+// Returns the preflop all-in equity of the first pocket against the second.
+// Matchups missing from the table are worked out by enumerating all the boards.
+func (t *PreflopTable) PreflopEquity(pocket1 uint64, pocket2 uint64) (float64, error) {
+	if bitCount(pocket1) != 2 || bitCount(pocket2) != 2 || (pocket1|pocket2)&^FullDeck != 0 {
+		return 0, ErrBadHand
+	}
+
+	a, _ := MaskIndex(pocket1)
+	b, _ := MaskIndex(pocket2)
+	if equity := t.equities[a*PocketCount+b]; !math.IsNaN(float64(equity)) {
+		return float64(equity), nil
+	}
+
+	results, err := t.cache.HandEquity(pocket1, pocket2, 0)
+	if err != nil {
+		return 0, err
+	}
+	return results[0].Equity, nil
+}
+
+// Returns the preflop all-in equity of a hand class against another (see PreflopClass()),
+// the average over all the non conflicting pockets of the two classes.
+func (t *PreflopTable) ClassEquity(class1 int, class2 int) (float64, error) {
+	if class1 < 0 || class1 >= PreflopClassCount || class2 < 0 || class2 >= PreflopClassCount {
+		return 0, ErrBadHand
+	}
+
+	equity := float64(t.classes[class1*PreflopClassCount+class2])
+	if math.IsNaN(equity) {
+		return 0, ErrNoPreflopEquity
+	}
+	return equity, nil
+}
+
+// Returns the hand class of a pocket. Classes are numbered as a 13x13 grid with
+// the ranks going down the rows and across the columns: pairs on the diagonal,
+// suited hands in the row of their top card and offsuit hands in the row of
+// their bottom card.
+func PreflopClass(pocket uint64) (int, error) {
+	if bitCount(pocket) != 2 || pocket&^FullDeck != 0 {
+		return 0, ErrBadHand
+	}
+	return preflopClass(pocket), nil
+}
+
+func preflopClass(pocket uint64) int {
+	card1, card2 := -1, -1
+	for card := 0; card < NumberOfCards; card++ {
+		if pocket&CardMasksTable[card] != 0 {
+			card1, card2 = card2, card
+		}
+	}
+
+	high, low := card2%13, card1%13
+	if high < low {
+		high, low = low, high
+	}
+	if card1/13 == card2/13 {
+		return high*13 + low
+	}
+	return low*13 + high
+}
+
+// Returns the name of a hand class, such as AA, AKs or 72o.
+func PreflopClassName(class int) string {
+	if class < 0 || class >= PreflopClassCount {
+		return ""
+	}
+
+	row, column := class/13, class%13
+	switch {
+	case row == column:
+		return CardTable[row][:1] + CardTable[column][:1]
+	case row > column:
+		return CardTable[row][:1] + CardTable[column][:1] + "s"
+	default:
+		return CardTable[column][:1] + CardTable[row][:1] + "o"
+	}
+}
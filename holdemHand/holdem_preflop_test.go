@@ -0,0 +1,124 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPreflopClass(t *testing.T) {
+	tests := []struct {
+		hand string
+		name string
+	}{
+		{"As Ah", "AA"},
+		{"2c 2d", "22"},
+		{"As Ks", "AKs"},
+		{"Kh Ad", "AKo"},
+		{"7c 2d", "72o"},
+		{"3h 2h", "32s"},
+	}
+
+	seen := map[int]bool{}
+	for _, test := range tests {
+		pocket, _ := ParseHand(test.hand)
+		class, err := PreflopClass(pocket)
+		if err != nil {
+			t.Fatalf("PreflopClass(%s) failed: %v", test.hand, err)
+		}
+		if name := PreflopClassName(class); name != test.name {
+			t.Fatalf("Incorrect class for %s. Want %s, Got %s", test.hand, test.name, name)
+		}
+		seen[class] = true
+	}
+
+	counts := map[int]int{}
+	for _, pocket := range TwoCardMaskTable {
+		class, _ := PreflopClass(pocket)
+		counts[class]++
+	}
+	if len(counts) != PreflopClassCount {
+		t.Fatalf("Incorrect number of classes. Want %d, Got %d", PreflopClassCount, len(counts))
+	}
+	for class, count := range counts {
+		if want := map[int]int{0: 6, 1: 4, -1: 12}[sign(class/13-class%13)]; count != want {
+			t.Fatalf("Class %s has %d pockets, expecting %d", PreflopClassName(class), count, want)
+		}
+	}
+}
+
+func sign(n int) int {
+	return min(max(n, -1), 1)
+}
+
+func TestPreflopMatchups(t *testing.T) {
+	matchups := PreflopMatchups()
+	if len(matchups) != 47008 {
+		t.Fatalf("Incorrect number of matchups. Want 47008, Got %d", len(matchups))
+	}
+
+	for _, matchup := range matchups {
+		if bitCount(matchup[0]) != 2 || bitCount(matchup[1]) != 2 || matchup[0]&matchup[1] != 0 {
+			t.Fatalf("Bad matchup %s vs %s", MaskToString(matchup[0]), MaskToString(matchup[1]))
+		}
+	}
+}
+
+func TestPreflopTable(t *testing.T) {
+	aces, kings := []uint64{}, []uint64{}
+	for _, pocket := range TwoCardMaskTable {
+		switch PreflopClassName(preflopClass(pocket)) {
+		case "AA":
+			aces = append(aces, pocket)
+		case "KK":
+			kings = append(kings, pocket)
+		}
+	}
+
+	// only the suit patterns of aces against kings need working out
+	cache := NewEquityCache()
+	matchups := [][2]uint64{}
+	for _, a := range aces {
+		for _, k := range kings {
+			matchups = append(matchups, [2]uint64{a, k})
+		}
+	}
+	lastProgress := Progress{}
+	err := cache.GenerateMatchups(context.Background(), matchups,
+		ParallelOptions{Progress: func(progress Progress) { lastProgress = progress }})
+	if err != nil {
+		t.Fatalf("GenerateMatchups() failed: %v", err)
+	}
+	if cache.Len() != 3 || lastProgress.Processed != 3 {
+		t.Fatalf("Expecting 3 matchups, got %d and progress %+v", cache.Len(), lastProgress)
+	}
+
+	want, _ := HandEquity([]uint64{kings[0], aces[0]}, 0, 0)
+	if got, _ := cache.HandEquity(kings[0], aces[0], 0); got[0] != want[0] {
+		t.Fatalf("Incorrect equity. Want %+v, Got %+v", want[0], got[0])
+	}
+
+	table := NewPreflopTable(cache)
+	classAces, _ := PreflopClass(aces[0])
+	classKings, _ := PreflopClass(kings[0])
+	equity, err := table.ClassEquity(classAces, classKings)
+	if err != nil || math.Abs(equity-0.8195) > 0.0005 {
+		t.Fatalf("Expecting AA to have about 82%% against KK, got %v, %v", equity, err)
+	}
+	reverse, _ := table.ClassEquity(classKings, classAces)
+	if math.Abs(equity+reverse-1) > 1e-6 {
+		t.Fatalf("AA vs KK and KK vs AA don't add up, %v and %v", equity, reverse)
+	}
+
+	if got, _ := table.PreflopEquity(kings[0], aces[0]); math.Abs(got-want[0].Equity) > 1e-6 {
+		t.Fatalf("Incorrect equity. Want %v, Got %v", want[0].Equity, got)
+	}
+
+	if _, err := table.ClassEquity(classAces, classAces); !errors.Is(err, ErrNoPreflopEquity) {
+		t.Fatalf("Expecting ErrNoPreflopEquity, got %v", err)
+	}
+	if _, err := table.PreflopEquity(aces[0], aces[0]); err == nil {
+		t.Fatal("Expecting an error for conflicting pockets")
+	}
+}
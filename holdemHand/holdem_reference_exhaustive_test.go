@@ -0,0 +1,23 @@
+//go:build exhaustive
+
+package holdemHand
+
+import "testing"
+
+// These compare every six and seven card hand with the reference evaluator,
+// which takes a long time. Run them with
+//
+//	go test -tags exhaustive -run Exhaustive -timeout 0
+
+func TestExhaustiveSixCardHands(t *testing.T) {
+	want := [StraightFlush + 1]uint64{6612900, 9730740, 2532816, 732160, 361620, 205792, 165984, 14664, 1844}
+	if got := checkAgainstReference(t, 6); got != want {
+		t.Fatalf("Incorrect hand type counts. Want %v, Got %v", want, got)
+	}
+}
+
+func TestExhaustiveSevenCardHands(t *testing.T) {
+	if got := checkAgainstReference(t, 7); got != sevenCardHandTypes {
+		t.Fatalf("Incorrect hand type counts. Want %v, Got %v", sevenCardHandTypes, got)
+	}
+}
@@ -0,0 +1,206 @@
+package holdemHand
+
+import (
+	"context"
+	"sort"
+	"testing"
+)
+
+// A slow but obviously correct evaluator to check the real ones against. It sorts
+// the ranks of every five card subset of the hand, counts them and checks for
+// straights and flushes, keeping the best. The hand values are built the same
+// way as EvaluateMask(): the hand type, then the ranks that decide ties.
+func referenceEvaluate(mask uint64) uint {
+	cards := []int{}
+	for card := 0; card < NumberOfCards; card++ {
+		if mask&CardMasksTable[card] != 0 {
+			cards = append(cards, card)
+		}
+	}
+
+	best := uint(0)
+	var subset [5]int
+	var choose func(start int, n int)
+	choose = func(start int, n int) {
+		if n == len(subset) {
+			best = max(best, referenceEvaluate5(subset))
+			return
+		}
+		for i := start; i <= len(cards)-(len(subset)-n); i++ {
+			subset[n] = cards[i]
+			choose(i+1, n+1)
+		}
+	}
+	choose(0, 0)
+	return best
+}
+
+func referenceEvaluate5(cards [5]int) uint {
+	// ranks grouped by how many of each there are, the biggest groups and
+	// then the highest ranks first
+	var counts [13]int
+	flush := true
+	for _, card := range cards {
+		counts[card%13]++
+		flush = flush && card/13 == cards[0]/13
+	}
+
+	type group struct{ rank, count int }
+	groups := []group{}
+	for rank, count := range counts {
+		if count > 0 {
+			groups = append(groups, group{rank, count})
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].count != groups[j].count {
+			return groups[i].count > groups[j].count
+		}
+		return groups[i].rank > groups[j].rank
+	})
+
+	ranks := []int{}
+	for _, g := range groups {
+		ranks = append(ranks, g.rank)
+	}
+
+	straight := -1
+	if len(groups) == 5 {
+		if ranks[0]-ranks[4] == 4 {
+			straight = ranks[0]
+		} else if ranks[0] == RankAce && ranks[1] == Rank5 {
+			// the wheel, A-2-3-4-5
+			straight = Rank5
+		}
+	}
+
+	value := func(handType int, ranks ...int) uint {
+		v := uint(handType) << HANDTYPE_SHIFT
+		for i, rank := range ranks {
+			v |= uint(rank) << (TOP_CARD_SHIFT - CARD_WIDTH*uint(i))
+		}
+		return v
+	}
+
+	switch {
+	case straight >= 0 && flush:
+		return value(StraightFlush, straight)
+	case groups[0].count == 4:
+		return value(FourOfAKind, ranks[0], ranks[1])
+	case groups[0].count == 3 && groups[1].count == 2:
+		return value(FullHouse, ranks[0], ranks[1])
+	case flush:
+		return value(Flush, ranks...)
+	case straight >= 0:
+		return value(Straight, straight)
+	case groups[0].count == 3:
+		return value(Trips, ranks...)
+	case groups[0].count == 2 && groups[1].count == 2:
+		return value(TwoPair, ranks...)
+	case groups[0].count == 2:
+		return value(Pair, ranks...)
+	}
+	return value(HighCard, ranks...)
+}
+
+// Compares EvaluateMask() and EvaluateType() with the reference evaluator on every
+// hand with the given number of cards, sharing the hands out to all the cores.
+func checkAgainstReference(t *testing.T, numCards int) [StraightFlush + 1]uint64 {
+	type mismatches struct {
+		handTypes [StraightFlush + 1]uint64
+		masks     []uint64
+	}
+
+	result, err := HandsRangeParallel(context.Background(), numCards, ParallelOptions{},
+		func() *mismatches { return &mismatches{} },
+		func(acc *mismatches, mask uint64) {
+			want := referenceEvaluate(mask)
+			acc.handTypes[want>>HANDTYPE_SHIFT]++
+
+			got, err := EvaluateMask(mask)
+			if (err != nil || got != want || EvaluateType(mask) != int(want>>HANDTYPE_SHIFT)) && len(acc.masks) < 10 {
+				acc.masks = append(acc.masks, mask)
+			}
+		},
+		func(dst *mismatches, src *mismatches) {
+			for i := range dst.handTypes {
+				dst.handTypes[i] += src.handTypes[i]
+			}
+			dst.masks = append(dst.masks, src.masks...)
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mask := range result.masks {
+		got, _ := EvaluateMask(mask)
+		t.Errorf("%s: EvaluateMask() = %#x, EvaluateType() = %d, expecting %#x",
+			MaskToString(mask), got, EvaluateType(mask), referenceEvaluate(mask))
+	}
+	return result.handTypes
+}
+
+func TestReferenceEvaluator(t *testing.T) {
+	tests := []struct {
+		hand     string
+		handType int
+	}{
+		{"As Ks Qs Js Ts", StraightFlush},
+		{"5d 4d 3d 2d Ad", StraightFlush},
+		{"9c 9d 9h 9s 2c", FourOfAKind},
+		{"9c 9d 9h 2s 2c", FullHouse},
+		{"Ac Jc 9c 5c 2c", Flush},
+		{"Ac 2d 3h 4s 5c", Straight},
+		{"9c 9d 9h 3s 2c", Trips},
+		{"9c 9d 3h 3s 2c", TwoPair},
+		{"9c 9d 4h 3s 2c", Pair},
+		{"Kc 9d 4h 3s 2c", HighCard},
+		{"Ac 2d 3h 4s 5c 6d 7h", Straight},
+		{"Ac Ad Ah Kc Kd Qh Qs", FullHouse},
+	}
+
+	for _, test := range tests {
+		mask, _ := ParseHand(test.hand)
+		if got := int(referenceEvaluate(mask) >> HANDTYPE_SHIFT); got != test.handType {
+			t.Fatalf("Incorrect reference hand type for %s. Want %d, Got %d", test.hand, test.handType, got)
+		}
+	}
+}
+
+func TestEvaluateMaskAllFiveCardHands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Checking all five card hands is slow")
+	}
+
+	want := [StraightFlush + 1]uint64{1302540, 1098240, 123552, 54912, 10200, 5108, 3744, 624, 40}
+	if got := checkAgainstReference(t, 5); got != want {
+		t.Fatalf("Incorrect hand type counts. Want %v, Got %v", want, got)
+	}
+}
+
+// Known hand type counts for all 7 card hands, see
+// https://en.wikipedia.org/wiki/Poker_probability
+var sevenCardHandTypes = [StraightFlush + 1]uint64{
+	23294460, 58627800, 31433400, 6461620, 6180020, 4047644, 3473184, 224848, 41584}
+
+func TestEvaluateTypeSevenCardCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Enumerating all seven card hands is slow")
+	}
+
+	got, err := HandsRangeParallel(context.Background(), 7, ParallelOptions{},
+		func() *[StraightFlush + 1]uint64 { return &[StraightFlush + 1]uint64{} },
+		func(handTypes *[StraightFlush + 1]uint64, mask uint64) { handTypes[EvaluateType(mask)]++ },
+		func(dst *[StraightFlush + 1]uint64, src *[StraightFlush + 1]uint64) {
+			for i := range dst {
+				dst[i] += src[i]
+			}
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *got != sevenCardHandTypes {
+		t.Fatalf("Incorrect hand type counts. Want %v, Got %v", sevenCardHandTypes, *got)
+	}
+}
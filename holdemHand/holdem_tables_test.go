@@ -0,0 +1,61 @@
+package holdemHand
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"holdemHand/internal/tables"
+)
+
+func TestGeneratedTablesFile(t *testing.T) {
+	want, err := tables.Source()
+	if err != nil {
+		t.Fatalf("Unable to generate the tables: %v", err)
+	}
+
+	got, err := os.ReadFile("holdem_tables.go")
+	if err != nil {
+		t.Fatalf("Unable to read holdem_tables.go: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Fatalf("holdem_tables.go is out of date, run go generate")
+	}
+}
+
+func TestGeneratedTables(t *testing.T) {
+	compareUintTable(t, "BitsAndStrTable", BitsAndStrTable[:], tables.BitsAndStr())
+	compareUintTable(t, "BitsTable", BitsTable[:], tables.Bits())
+	compareUintTable(t, "StraightTable", StraightTable[:], tables.Straight())
+	compareUintTable(t, "TopCardTable", TopCardTable[:], tables.TopCard())
+	compareUintTable(t, "TopFiveCardsTable", TopFiveCardsTable[:], tables.TopFiveCards())
+	compareUint64Table(t, "CardMasksTable", CardMasksTable[:], tables.CardMasks())
+	compareUint64Table(t, "TwoCardMaskTable", TwoCardMaskTable[:], tables.TwoCardMasks())
+
+	if len(CardMasksTable) != CardsMasksTableSize || len(TwoCardMaskTable) != 1326 {
+		t.Fatalf("Incorrect card mask table sizes")
+	}
+}
+
+func compareUintTable(t *testing.T, name string, got []uint, want []uint) {
+	if len(got) != len(want) {
+		t.Fatalf("%s has %d entries, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s[%d] is %#x, want %#x", name, i, got[i], want[i])
+		}
+	}
+}
+
+func compareUint64Table(t *testing.T, name string, got []uint64, want []uint64) {
+	if len(got) != len(want) {
+		t.Fatalf("%s has %d entries, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s[%d] is %#x, want %#x", name, i, got[i], want[i])
+		}
+	}
+}
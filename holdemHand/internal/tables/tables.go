@@ -0,0 +1,161 @@
+// Derives the lookup tables used by the hand evaluator from first principles.
+// The tables are written to holdem_tables.go by cmd/gentables.
+package tables
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math/bits"
+)
+
+const (
+	// ranks in a suit
+	NumberOfRanks = 13
+	// every combination of ranks in a suit
+	RankMasksSize = 1 << NumberOfRanks
+	NumberOfCards = 52
+
+	topCardShift = 16
+	cardWidth    = 4
+	rankAce      = 12
+	rank5        = 3
+)
+
+// The number of bits (ranks) set in each rank mask
+func Bits() []uint {
+	table := make([]uint, RankMasksSize)
+	for ranks := range table {
+		table[ranks] = uint(bits.OnesCount(uint(ranks)))
+	}
+	return table
+}
+
+// The top card of the best straight in each rank mask, zero if there is no straight.
+// A five high straight (the wheel) plays the ace low.
+func Straight() []uint {
+	table := make([]uint, RankMasksSize)
+	for ranks := range table {
+		for top := rankAce; top >= rank5; top-- {
+			straight := 0xF | 1<<rankAce
+			if top > rank5 {
+				straight = 0x1F << (top - 4)
+			}
+			if ranks&straight == straight {
+				table[ranks] = uint(top)
+				break
+			}
+		}
+	}
+	return table
+}
+
+// The number of ranks in each rank mask shifted left by two, bit one is set when
+// the ranks make a straight and bit zero when there are enough ranks for a flush
+func BitsAndStr() []uint {
+	bitsTable := Bits()
+	straightTable := Straight()
+	table := make([]uint, RankMasksSize)
+	for ranks := range table {
+		table[ranks] = bitsTable[ranks] << 2
+		if straightTable[ranks] != 0 {
+			table[ranks] |= 0x2
+		}
+		if bitsTable[ranks] >= 5 {
+			table[ranks] |= 0x1
+		}
+	}
+	return table
+}
+
+// The highest rank in each rank mask
+func TopCard() []uint {
+	table := make([]uint, RankMasksSize)
+	for ranks := 1; ranks < len(table); ranks++ {
+		table[ranks] = uint(bits.Len(uint(ranks)) - 1)
+	}
+	return table
+}
+
+// The five highest ranks in each rank mask, packed four bits per rank with
+// the highest rank at bit 16
+func TopFiveCards() []uint {
+	table := make([]uint, RankMasksSize)
+	for ranks := range table {
+		shift := topCardShift
+		for rank := rankAce; rank >= 0 && shift >= 0; rank-- {
+			if ranks&(1<<rank) != 0 {
+				table[ranks] |= uint(rank) << shift
+				shift -= cardWidth
+			}
+		}
+	}
+	return table
+}
+
+// The mask of each card
+func CardMasks() []uint64 {
+	table := make([]uint64, NumberOfCards)
+	for card := range table {
+		table[card] = uint64(1) << card
+	}
+	return table
+}
+
+// The mask of every two card hand, highest cards first
+func TwoCardMasks() []uint64 {
+	table := make([]uint64, 0, NumberOfCards*(NumberOfCards-1)/2)
+	for a := NumberOfCards - 1; a >= 0; a-- {
+		for b := a - 1; b >= 0; b-- {
+			table = append(table, uint64(1)<<a|uint64(1)<<b)
+		}
+	}
+	return table
+}
+
+// The source of holdem_tables.go
+func Source() ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteString("// Code generated by cmd/gentables; DO NOT EDIT.\n\n")
+	buf.WriteString("package holdemHand\n")
+
+	writeUintTable(&buf, "BitsAndStrTable", BitsAndStr(), "%#x", 20)
+	writeUintTable(&buf, "BitsTable", Bits(), "%d", 30)
+	writeUintTable(&buf, "StraightTable", Straight(), "%#x", 20)
+	writeUintTable(&buf, "TopCardTable", TopCard(), "%#x", 20)
+	writeUintTable(&buf, "TopFiveCardsTable", TopFiveCards(), "%#x", 10)
+	writeUint64Table(&buf, "CardMasksTable", CardMasks(), 4)
+	writeUint64Table(&buf, "TwoCardMaskTable", TwoCardMasks(), 5)
+
+	return format.Source(buf.Bytes())
+}
+
+func writeUintTable(buf *bytes.Buffer, name string, table []uint, verb string, perLine int) {
+	values := make([]string, len(table))
+	for i, value := range table {
+		values[i] = fmt.Sprintf(verb, value)
+	}
+	writeTable(buf, name, "uint", values, perLine)
+}
+
+func writeUint64Table(buf *bytes.Buffer, name string, table []uint64, perLine int) {
+	values := make([]string, len(table))
+	for i, value := range table {
+		values[i] = fmt.Sprintf("%#x", value)
+	}
+	writeTable(buf, name, "uint64", values, perLine)
+}
+
+func writeTable(buf *bytes.Buffer, name string, typeName string, values []string, perLine int) {
+	fmt.Fprintf(buf, "\nvar %s = [...]%s{", name, typeName)
+	for i, value := range values {
+		if i%perLine == 0 {
+			buf.WriteString("\n\t")
+		} else {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(value)
+		buf.WriteString(",")
+	}
+	buf.WriteString("\n}\n")
+}
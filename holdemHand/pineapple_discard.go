@@ -0,0 +1,81 @@
+package holdemHand
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// One of the three ways to discard from a pineapple hand
+type PineappleOption struct {
+	Keep    uint64
+	Discard uint64
+	Result  EquityResult
+}
+
+// Given three hole cards, works out the equity of keeping each of the three
+// two card hands against the opponents' hands or ranges (see RangeEquity()).
+// Pass an empty flop for Pineapple, where the discard happens before the flop,
+// or the three flop cards for Crazy Pineapple. The discarded card is dead.
+// The options are returned best first.
+func PineappleDiscard(hole uint64, flop uint64, opponents [][]uint64) ([]PineappleOption, error) {
+	return PineappleDiscardContext(context.Background(), hole, flop, opponents, nil)
+}
+
+// Same as PineappleDiscard() but stops early when the context is cancelled, returning the
+// context's error. progress, which can be nil, is called every so often with the
+// number of boards played out so far for all three options.
+func PineappleDiscardContext(ctx context.Context, hole uint64, flop uint64, opponents [][]uint64,
+	progress func(Progress)) ([]PineappleOption, error) {
+	if bitCount(hole) != 3 {
+		return nil, errors.New("Pineapple hands have three hole cards")
+	}
+
+	if (flop != 0 && bitCount(flop) != 3) || flop&hole != 0 {
+		return nil, errors.New("Bad flop definition")
+	}
+
+	if len(opponents) == 0 {
+		return nil, errors.New("At least one opponent is required")
+	}
+
+	discards := make([]uint64, 0, 3)
+	for a := 0; a < CardsMasksTableSize; a++ {
+		if hole&CardMasksTable[a] != 0 {
+			discards = append(discards, CardMasksTable[a])
+		}
+	}
+
+	ranges := make([][]uint64, len(opponents)+1)
+	copy(ranges[1:], opponents)
+	total := uint64(0)
+	for _, discard := range discards {
+		ranges[0] = []uint64{hole &^ discard}
+		boards, err := equityBoards(Holdem, ranges, flop, discard)
+		if err != nil {
+			return nil, err
+		}
+		total += boards
+	}
+
+	tracker := newProgressTracker(ctx, progress, total)
+	options := make([]PineappleOption, 0, 3)
+	for _, discard := range discards {
+		keep := hole &^ discard
+		ranges[0] = []uint64{keep}
+		results, err := RangeEquityContext(ctx, ranges, flop, discard, tracker.forward())
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, PineappleOption{Keep: keep, Discard: discard, Result: results[0]})
+	}
+
+	tracker.done()
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].Result.Equity > options[j].Result.Equity
+	})
+
+	return options, nil
+}
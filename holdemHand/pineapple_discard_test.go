@@ -0,0 +1,36 @@
+package holdemHand
+
+import (
+	"testing"
+)
+
+func TestPineappleDiscard(t *testing.T) {
+	hole, _ := ParseHand("As Ah 7c")
+	flop, _ := ParseHand("Kd 8h 2s")
+	villain, _ := ParseHand("Kc Qc")
+
+	options, err := PineappleDiscard(hole, flop, [][]uint64{{villain}})
+	if err != nil {
+		t.Fatalf("PineappleDiscard() failed: %v", err)
+	}
+
+	if len(options) != 3 {
+		t.Fatalf("Expecting three discard options, got %d", len(options))
+	}
+
+	// the aces are way ahead of a pair of kings, an ace seven isn't
+	want, _ := ParseHand("7c")
+	if options[0].Discard != want {
+		t.Fatalf("Expecting to discard the 7c, got %s", MaskToString(options[0].Discard))
+	}
+
+	for i := 1; i < len(options); i++ {
+		if options[i].Result.Equity > options[i-1].Result.Equity {
+			t.Fatalf("Options should be sorted best first")
+		}
+	}
+
+	if _, err := PineappleDiscard(hole&^want, flop, [][]uint64{{villain}}); err == nil {
+		t.Fatalf("Expecting an error for a two card hand")
+	}
+}